@@ -0,0 +1,49 @@
+// cmd/server/healthcheck.go
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// runHealthcheck queries the grpc.health.v1 service of a running server and
+// returns a process exit code: 0 when SERVING, 1 otherwise. It is meant to
+// be used as a container healthcheck.
+func runHealthcheck(args []string) int {
+	flags := flag.NewFlagSet("healthcheck", flag.ContinueOnError)
+	addr := flags.String("addr", "localhost:8080", "gRPC server address")
+	service := flags.String("service", "", "service name to check (empty for overall status)")
+	timeout := flags.Duration("timeout", 3*time.Second, "probe timeout")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "healthcheck: %v\n", err)
+		return 1
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: *service})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "healthcheck: %v\n", err)
+		return 1
+	}
+
+	fmt.Println(resp.Status)
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return 1
+	}
+	return 0
+}
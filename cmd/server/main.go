@@ -3,46 +3,139 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/soheilhy/cmux"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"tictactoe/internal/adapters/connectapi"
 	"tictactoe/internal/adapters/grpc/handler"
+	"tictactoe/internal/adapters/grpc/interceptor"
+	"tictactoe/internal/adapters/health"
+	"tictactoe/internal/adapters/live"
+	"tictactoe/internal/adapters/logging"
+	"tictactoe/internal/adapters/metrics"
+	"tictactoe/internal/adapters/pubsub"
 	"tictactoe/internal/adapters/repository"
+	"tictactoe/internal/adapters/rest"
+	"tictactoe/internal/adapters/tracing"
 	"tictactoe/internal/application/service"
 	"tictactoe/internal/domain/config"
 	pb "tictactoe/proto"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "healthcheck" {
+		os.Exit(runHealthcheck(os.Args[2:]))
+	}
+
+	// Setup logging
+	logger, err := logging.New(logging.ConfigFromEnv(), os.Stdout)
+	if err != nil {
+		slog.Error("Invalid logging configuration", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+
+	// Setup tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.ConfigFromEnv(), os.Stdout)
+	if err != nil {
+		logger.Error("Invalid tracing configuration", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	tracerProvider := otel.GetTracerProvider()
+
 	// Load configuration
 	cfg := config.DefaultConfig()
 
+	// Setup metrics
+	serverMetrics := metrics.New()
+
 	// Initialize repositories (in-memory)
-	gameRepo := repository.NewInMemoryGameRepository()
-	userRepo := repository.NewInMemoryUserRepository()
+	gameRepo := tracing.NewGameRepository(repository.NewLoggingGameRepository(
+		serverMetrics.InstrumentGameRepository(repository.NewInMemoryGameRepository()), logger), tracerProvider)
+	userRepo := tracing.NewUserRepository(repository.NewLoggingUserRepository(
+		serverMetrics.InstrumentUserRepository(repository.NewInMemoryUserRepository()), logger), tracerProvider)
+	serverMetrics.RegisterGameRepository(gameRepo)
+
+	// Live game updates
+	broker := pubsub.NewBroker()
 
 	// Initialize services
-	gameService := service.NewGameService(gameRepo, userRepo, cfg)
+	gameService := tracing.NewGameService(service.NewGameService(gameRepo, userRepo, cfg,
+		service.WithLogger(logger),
+		service.WithMetrics(serverMetrics),
+		service.WithEventPublisher(broker),
+		service.WithSpectatorTracker(broker),
+	), tracerProvider)
 
-	// Initialize gRPC handler
-	grpcHandler := handler.NewGRPCHandler(gameService)
+	adminService := service.NewAdminService(gameRepo, userRepo,
+		service.WithLogger(logger),
+		service.WithMetrics(serverMetrics),
+		service.WithEventPublisher(broker),
+		service.WithStreamCounter(broker),
+	)
+
+	// Initialize gRPC handlers
+	grpcHandler := handler.NewGRPCHandler(gameService, handler.WithLogger(logger), handler.WithBroker(broker))
+	adminHandler := handler.NewAdminHandler(adminService, handler.WithLogger(logger), handler.WithBroker(broker))
+	adminToken := os.Getenv("ADMIN_TOKEN")
 
 	// Setup gRPC server
-	lis, err := net.Listen("tcp", ":8080")
+	lis, err := net.Listen("tcp", envOrDefault("GRPC_ADDR", ":8080"))
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		logger.Error("Failed to listen", slog.String("error", err.Error()))
+		os.Exit(1)
 	}
 
-	server := grpc.NewServer()
+	server := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			interceptor.UnaryLogging(logger),
+			interceptor.UnaryMetrics(serverMetrics),
+			interceptor.UnaryBearerAuth(adminToken, pb.AdminService_ServiceDesc.ServiceName),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.StreamLogging(logger),
+			interceptor.StreamMetrics(serverMetrics),
+		),
+	)
 	pb.RegisterTicTacToeServiceServer(server, grpcHandler)
 
+	// AdminService is only exposed when an admin credential is configured
+	if adminToken != "" {
+		pb.RegisterAdminServiceServer(server, adminHandler)
+	} else {
+		logger.Warn("ADMIN_TOKEN is not set; AdminService is disabled")
+	}
+
+	// Register standard health checking, driven by repository health
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	healthMonitor := health.NewMonitor(healthServer,
+		[]string{pb.TicTacToeService_ServiceDesc.ServiceName},
+		[]health.Check{
+			{Name: "game_repository", Pinger: gameRepo},
+			{Name: "user_repository", Pinger: userRepo},
+		},
+		logger,
+	)
+
 	// Enable reflection for testing
 	reflection.Register(server)
 
@@ -50,10 +143,100 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	go healthMonitor.Run(ctx, 10*time.Second)
+
+	// Setup metrics HTTP server
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", serverMetrics.Handler())
+	metricsServer := &http.Server{
+		Addr:              envOrDefault("METRICS_ADDR", ":9090"),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
 	go func() {
-		log.Println("Starting gRPC server on :8080")
-		if err := server.Serve(lis); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
+		logger.Info("Starting metrics server", slog.String("addr", metricsServer.Addr))
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Failed to serve metrics", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+	}()
+
+	// Browser-facing adapters call the gRPC server over loopback
+	loopbackConn, err := grpc.NewClient(
+		loopbackAddr(lis.Addr()),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		logger.Error("Failed to create loopback client", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer loopbackConn.Close()
+
+	// Serve native gRPC, gRPC-Web and Connect on the same port. Native gRPC
+	// is recognized by its HTTP/2 content type and stays on server.Serve so
+	// that GracefulStop drains it; everything else is handled over HTTP/1.1
+	// or cleartext HTTP/2.
+	portMux := cmux.New(lis)
+	grpcLis := portMux.MatchWithWriters(
+		cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"),
+		cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc+proto"),
+	)
+	webLis := portMux.Match(cmux.Any())
+
+	// Browser origins allowed by the gRPC-Web/Connect and live endpoints
+	corsConfig := connectapi.CORSConfigFromEnv()
+
+	webServer := &http.Server{
+		Handler:           h2c.NewHandler(connectapi.NewHandler(loopbackConn, corsConfig), &http2.Server{}),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		logger.Info("Starting gRPC server", slog.String("addr", lis.Addr().String()))
+		if err := server.Serve(grpcLis); err != nil {
+			logger.Error("Failed to serve", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+	}()
+
+	go func() {
+		if err := webServer.Serve(webLis); err != nil && !errors.Is(err, http.ErrServerClosed) && !errors.Is(err, cmux.ErrListenerClosed) {
+			logger.Error("Failed to serve gRPC-Web and Connect", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+	}()
+
+	go func() {
+		if err := portMux.Serve(); err != nil && !errors.Is(err, net.ErrClosed) {
+			logger.Warn("Port multiplexer stopped", slog.String("error", err.Error()))
+		}
+	}()
+
+	// Setup REST/JSON gateway
+	gatewayHandler, err := rest.NewHandler(ctx, loopbackConn)
+	if err != nil {
+		logger.Error("Failed to create REST gateway", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/", gatewayHandler)
+	liveHandler := live.NewHandler(gameService, broker, corsConfig, logger)
+	httpMux.Handle("GET /v1/games/{game_id}/live", liveHandler)
+
+	httpServer := &http.Server{
+		Addr:              envOrDefault("HTTP_ADDR", ":8081"),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	httpServer.RegisterOnShutdown(liveHandler.Close)
+
+	go func() {
+		logger.Info("Starting REST gateway", slog.String("addr", httpServer.Addr))
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Failed to serve REST gateway", slog.String("error", err.Error()))
+			os.Exit(1)
 		}
 	}()
 
@@ -62,12 +245,29 @@ func main() {
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	<-c
 
-	log.Println("Shutting down server...")
+	logger.Info("Shutting down server...")
+
+	// Stop advertising readiness before draining connections
+	healthMonitor.Shutdown()
+
+	// End spectator streams, which would otherwise hold up every server
+	// forwarding them
+	grpcHandler.Close()
 
 	// Graceful shutdown with timeout
 	shutdownCtx, shutdownCancel := context.WithTimeout(ctx, 10*time.Second)
 	defer shutdownCancel()
 
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("REST gateway shutdown failed", slog.String("error", err.Error()))
+	}
+	if err := webServer.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("gRPC-Web and Connect shutdown failed", slog.String("error", err.Error()))
+	}
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("Metrics server shutdown failed", slog.String("error", err.Error()))
+	}
+
 	done := make(chan struct{})
 	go func() {
 		server.GracefulStop()
@@ -76,9 +276,35 @@ func main() {
 
 	select {
 	case <-shutdownCtx.Done():
-		log.Println("Shutdown timeout exceeded, forcing stop")
+		logger.Warn("Shutdown timeout exceeded, forcing stop")
 		server.Stop()
 	case <-done:
-		log.Println("Server stopped gracefully")
+		logger.Info("Server stopped gracefully")
+	}
+	portMux.Close()
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logger.Warn("Tracing shutdown failed", slog.String("error", err.Error()))
+	}
+}
+
+// loopbackAddr returns where this process can dial its own listener: the
+// listener's address, with a wildcard host such as "::" or "0.0.0.0"
+// replaced by 127.0.0.1.
+func loopbackAddr(addr net.Addr) string {
+	host, port, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	if ip := net.ParseIP(host); host == "" || ip != nil && ip.IsUnspecified() {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(host, port)
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
 	}
+	return fallback
 }
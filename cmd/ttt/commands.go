@@ -0,0 +1,119 @@
+// cmd/ttt/commands.go
+package main
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"google.golang.org/grpc/status"
+
+	pb "tictactoe/proto"
+)
+
+// Messages delivered to Update when requests complete.
+type (
+	gameMsg struct {
+		game    *pb.Game
+		message string
+	}
+	pendingMsg struct{ games []*pb.PendingGame }
+	statsMsg   struct{ stats *pb.UserStats }
+	errMsg     struct{ err error }
+	pollMsg    struct{ seq int }
+)
+
+func (m model) call(fn func(ctx context.Context) tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+		defer cancel()
+		return fn(ctx)
+	}
+}
+
+func (m model) startGame(boardSize, winningLength int32) tea.Cmd {
+	return m.call(func(ctx context.Context) tea.Msg {
+		resp, err := m.client.StartGame(ctx, &pb.StartGameRequest{
+			UserId:        m.userID,
+			BoardSize:     boardSize,
+			WinningLength: winningLength,
+		})
+		if err != nil {
+			return errMsg{err}
+		}
+		game, err := m.client.GetGame(ctx, &pb.GetGameRequest{GameId: resp.GameId, UserId: m.userID})
+		if err != nil {
+			return errMsg{err}
+		}
+		return gameMsg{game: game.Game, message: resp.Message}
+	})
+}
+
+func (m model) searchPendingGames() tea.Cmd {
+	return m.call(func(ctx context.Context) tea.Msg {
+		resp, err := m.client.SearchPendingGames(ctx, &pb.SearchPendingGamesRequest{UserId: m.userID})
+		if err != nil {
+			return errMsg{err}
+		}
+		return pendingMsg{games: resp.Games}
+	})
+}
+
+func (m model) joinGame(gameID string) tea.Cmd {
+	return m.call(func(ctx context.Context) tea.Msg {
+		resp, err := m.client.JoinGame(ctx, &pb.JoinGameRequest{UserId: m.userID, GameId: gameID})
+		if err != nil {
+			return errMsg{err}
+		}
+		return gameMsg{game: resp.Game, message: resp.Message}
+	})
+}
+
+func (m model) makeMove(gameID string, row, col int) tea.Cmd {
+	return m.call(func(ctx context.Context) tea.Msg {
+		resp, err := m.client.MakeMove(ctx, &pb.MakeMoveRequest{
+			UserId: m.userID,
+			GameId: gameID,
+			Row:    int32(row),
+			Col:    int32(col),
+		})
+		if err != nil {
+			return errMsg{err}
+		}
+		return gameMsg{game: resp.Game, message: resp.Message}
+	})
+}
+
+func (m model) getGame(gameID string) tea.Cmd {
+	return m.call(func(ctx context.Context) tea.Msg {
+		resp, err := m.client.GetGame(ctx, &pb.GetGameRequest{GameId: gameID, UserId: m.userID})
+		if err != nil {
+			return errMsg{err}
+		}
+		return gameMsg{game: resp.Game}
+	})
+}
+
+func (m model) getUserStats() tea.Cmd {
+	return m.call(func(ctx context.Context) tea.Msg {
+		resp, err := m.client.GetUserStats(ctx, &pb.GetUserStatsRequest{UserId: m.userID})
+		if err != nil {
+			return errMsg{err}
+		}
+		return statsMsg{stats: resp.Stats}
+	})
+}
+
+// poll schedules the next refresh of the current game. seq ties the tick to
+// the polling loop that scheduled it, so that stale loops stop on their own.
+func (m model) poll(seq int) tea.Cmd {
+	return tea.Tick(m.pollInterval, func(time.Time) tea.Msg { return pollMsg{seq: seq} })
+}
+
+// errorText returns the server's message for gRPC errors.
+func errorText(err error) string {
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
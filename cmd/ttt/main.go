@@ -0,0 +1,41 @@
+// cmd/ttt/main.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "tictactoe/proto"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "gRPC server address")
+	user := flag.String("user", os.Getenv("USER"), "user ID to play as")
+	timeout := flag.Duration("timeout", 5*time.Second, "timeout for each request")
+	poll := flag.Duration("poll", time.Second, "how often to refresh the current game")
+	flag.Parse()
+
+	if *user == "" {
+		fmt.Fprintln(os.Stderr, "ttt: -user is required")
+		os.Exit(2)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ttt: %v\n", err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	m := newModel(pb.NewTicTacToeServiceClient(conn), *user, *timeout, *poll)
+	if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "ttt: %v\n", err)
+		os.Exit(1)
+	}
+}
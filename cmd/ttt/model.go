@@ -0,0 +1,360 @@
+// cmd/ttt/model.go
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	pb "tictactoe/proto"
+)
+
+type screen int
+
+const (
+	screenMenu screen = iota
+	screenNewGame
+	screenPending
+	screenGame
+	screenStats
+)
+
+type menuItem struct {
+	key   string
+	label string
+}
+
+type model struct {
+	client       pb.TicTacToeServiceClient
+	userID       string
+	timeout      time.Duration
+	pollInterval time.Duration
+
+	screen     screen
+	menuCursor int
+	status     string
+	isError    bool
+
+	// New game form
+	sizeInput  string
+	winInput   string
+	inputField int
+
+	// Pending games list
+	pending       []*pb.PendingGame
+	pendingCursor int
+
+	// Current game
+	game      *pb.Game
+	lastMove  int
+	cursorRow int
+	cursorCol int
+	coords    string
+	pollSeq   int
+
+	stats *pb.UserStats
+}
+
+func newModel(client pb.TicTacToeServiceClient, userID string, timeout, pollInterval time.Duration) model {
+	return model{
+		client:       client,
+		userID:       userID,
+		timeout:      timeout,
+		pollInterval: pollInterval,
+		sizeInput:    "3",
+		winInput:     "3",
+		lastMove:     -1,
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) menuItems() []menuItem {
+	items := []menuItem{
+		{"n", "New game"},
+		{"s", "Search pending games"},
+		{"t", "Statistics"},
+	}
+	if m.game != nil && !finished(m.game) {
+		items = append([]menuItem{{"r", "Resume current game"}}, items...)
+	}
+	return append(items, menuItem{"q", "Quit"})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		switch m.screen {
+		case screenMenu:
+			return m.updateMenu(msg)
+		case screenNewGame:
+			return m.updateNewGame(msg)
+		case screenPending:
+			return m.updatePending(msg)
+		case screenGame:
+			return m.updateGame(msg)
+		case screenStats:
+			if isBack(msg) {
+				m.screen = screenMenu
+			}
+			return m, nil
+		}
+
+	case gameMsg:
+		m.setGame(msg.game)
+		if msg.message != "" {
+			m.setStatus(msg.message)
+		}
+		if m.screen != screenGame {
+			return m.enterGame()
+		}
+		return m, nil
+
+	case pendingMsg:
+		m.pending = msg.games
+		m.pendingCursor = 0
+		m.screen = screenPending
+		m.setStatus("")
+		return m, nil
+
+	case statsMsg:
+		m.stats = msg.stats
+		m.screen = screenStats
+		m.setStatus("")
+		return m, nil
+
+	case errMsg:
+		m.status = errorText(msg.err)
+		m.isError = true
+		return m, nil
+
+	case pollMsg:
+		if msg.seq != m.pollSeq || m.screen != screenGame || m.game == nil || finished(m.game) {
+			return m, nil
+		}
+		return m, tea.Batch(m.getGame(m.game.Id), m.poll(msg.seq))
+	}
+	return m, nil
+}
+
+func (m model) updateMenu(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	items := m.menuItems()
+	key := msg.String()
+	switch key {
+	case "up", "k":
+		m.menuCursor = (m.menuCursor + len(items) - 1) % len(items)
+		return m, nil
+	case "down", "j":
+		m.menuCursor = (m.menuCursor + 1) % len(items)
+		return m, nil
+	case "enter", " ":
+		key = items[m.menuCursor%len(items)].key
+	}
+
+	switch key {
+	case "n":
+		m.screen = screenNewGame
+		m.inputField = 0
+		m.setStatus("")
+	case "s":
+		return m, m.searchPendingGames()
+	case "t":
+		return m, m.getUserStats()
+	case "r":
+		if m.game != nil && !finished(m.game) {
+			return m.enterGame()
+		}
+	case "q", "esc":
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m model) updateNewGame(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	field := &m.sizeInput
+	if m.inputField == 1 {
+		field = &m.winInput
+	}
+
+	switch key := msg.String(); key {
+	case "esc":
+		m.screen = screenMenu
+	case "tab", "shift+tab", "up", "down":
+		m.inputField = 1 - m.inputField
+	case "backspace":
+		if *field != "" {
+			*field = (*field)[:len(*field)-1]
+		}
+	case "enter":
+		size, _ := strconv.Atoi(m.sizeInput)
+		win, _ := strconv.Atoi(m.winInput)
+		return m, m.startGame(int32(size), int32(win))
+	default:
+		if isDigit(key) && len(*field) < 2 {
+			*field += key
+		}
+	}
+	return m, nil
+}
+
+func (m model) updatePending(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		m.screen = screenMenu
+	case "up", "k":
+		if m.pendingCursor > 0 {
+			m.pendingCursor--
+		}
+	case "down", "j":
+		if m.pendingCursor < len(m.pending)-1 {
+			m.pendingCursor++
+		}
+	case "r":
+		return m, m.searchPendingGames()
+	case "enter", " ":
+		if len(m.pending) > 0 {
+			return m, m.joinGame(m.pending[m.pendingCursor].GameId)
+		}
+	}
+	return m, nil
+}
+
+func (m model) updateGame(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	width, height := boardDimensions(m.game.BoardWidth, m.game.BoardHeight, m.game.BoardSize)
+
+	// Coordinate entry: "<row> <col>" followed by enter, 1-based.
+	if m.coords != "" || isDigit(key) {
+		switch {
+		case isDigit(key):
+			m.coords += key
+		case key == " " || key == ",":
+			if !strings.HasSuffix(m.coords, " ") {
+				m.coords += " "
+			}
+		case key == "backspace":
+			m.coords = m.coords[:len(m.coords)-1]
+		case key == "esc":
+			m.coords = ""
+		case key == "enter":
+			row, col, ok := parseCoords(m.coords, width, height)
+			m.coords = ""
+			if !ok {
+				m.status, m.isError = "Enter coordinates as <row> <col>", true
+				return m, nil
+			}
+			m.cursorRow, m.cursorCol = row, col
+			return m.move(row, col)
+		}
+		return m, nil
+	}
+
+	switch key {
+	case "esc", "q":
+		m.screen = screenMenu
+		m.menuCursor = 0
+	case "up", "k":
+		m.cursorRow = max(m.cursorRow-1, 0)
+	case "down", "j":
+		m.cursorRow = min(m.cursorRow+1, height-1)
+	case "left", "h":
+		m.cursorCol = max(m.cursorCol-1, 0)
+	case "right", "l":
+		m.cursorCol = min(m.cursorCol+1, width-1)
+	case "enter", " ":
+		return m.move(m.cursorRow, m.cursorCol)
+	case "r":
+		return m, m.getGame(m.game.Id)
+	}
+	return m, nil
+}
+
+func (m model) move(row, col int) (tea.Model, tea.Cmd) {
+	if m.game.Status != pb.GameStatus_IN_PROGRESS {
+		m.status, m.isError = "The game is not in progress", true
+		return m, nil
+	}
+	if m.game.CurrentPlayerId != m.userID {
+		m.status, m.isError = "Wait for your opponent to move", true
+		return m, nil
+	}
+	return m, m.makeMove(m.game.Id, row, col)
+}
+
+// enterGame switches to the game screen and starts a new polling loop.
+func (m model) enterGame() (tea.Model, tea.Cmd) {
+	m.screen = screenGame
+	m.coords = ""
+	m.pollSeq++
+	return m, m.poll(m.pollSeq)
+}
+
+// setGame replaces the current game, remembering which cell changed so
+// that the opponent's latest move can be highlighted.
+func (m *model) setGame(game *pb.Game) {
+	m.lastMove = -1
+	if m.game != nil && m.game.Id == game.Id {
+		for i := range game.Board {
+			if i < len(m.game.Board) && game.Board[i] != m.game.Board[i] {
+				m.lastMove = i
+			}
+		}
+	} else {
+		m.cursorRow, m.cursorCol = 0, 0
+	}
+	m.game = game
+}
+
+func (m *model) setStatus(s string) {
+	m.status, m.isError = s, false
+}
+
+func finished(game *pb.Game) bool {
+	switch game.Status {
+	case pb.GameStatus_FINISHED_WIN, pb.GameStatus_FINISHED_DRAW, pb.GameStatus_ABANDONED:
+		return true
+	}
+	return false
+}
+
+func isBack(msg tea.KeyMsg) bool {
+	switch msg.String() {
+	case "esc", "q", "enter":
+		return true
+	}
+	return false
+}
+
+func isDigit(key string) bool {
+	return len(key) == 1 && key[0] >= '0' && key[0] <= '9'
+}
+
+// boardDimensions returns a board's width and height, falling back to its
+// board_size for servers that do not report them.
+func boardDimensions(width, height, size int32) (int, int) {
+	if width == 0 || height == 0 {
+		return int(size), int(size)
+	}
+	return int(width), int(height)
+}
+
+// parseCoords parses 1-based "<row> <col>" input into 0-based indices.
+func parseCoords(s string, width, height int) (row, col int, ok bool) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0, 0, false
+	}
+	row, err1 := strconv.Atoi(fields[0])
+	col, err2 := strconv.Atoi(fields[1])
+	if err1 != nil || err2 != nil || row < 1 || row > height || col < 1 || col > width {
+		return 0, 0, false
+	}
+	return row - 1, col - 1, true
+}
@@ -0,0 +1,221 @@
+// cmd/ttt/model_test.go
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"tictactoe/internal/adapters/grpc/handler"
+	"tictactoe/internal/adapters/repository"
+	"tictactoe/internal/application/service"
+	"tictactoe/internal/domain/config"
+	pb "tictactoe/proto"
+)
+
+func newTestClient(t *testing.T) pb.TicTacToeServiceClient {
+	t.Helper()
+
+	gameService := service.NewGameService(repository.NewInMemoryGameRepository(), repository.NewInMemoryUserRepository(), config.DefaultConfig())
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	pb.RegisterTicTacToeServiceServer(server, handler.NewGRPCHandler(gameService))
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewTicTacToeServiceClient(conn)
+}
+
+// press feeds keys to the model, running any request command synchronously.
+func press(t *testing.T, m model, keys ...string) model {
+	t.Helper()
+	for _, key := range keys {
+		var msg tea.KeyMsg
+		switch key {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "backspace":
+			msg = tea.KeyMsg{Type: tea.KeyBackspace}
+		case "right":
+			msg = tea.KeyMsg{Type: tea.KeyRight}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		case " ":
+			msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		}
+		m = run(t, m, msg)
+	}
+	return m
+}
+
+// run applies msg and then the result of the returned command, skipping
+// poll ticks, which are delivered explicitly by pollOnce.
+func run(t *testing.T, m model, msg tea.Msg) model {
+	t.Helper()
+	next, cmd := m.Update(msg)
+	m = next.(model)
+	if cmd == nil {
+		return m
+	}
+	if _, ok := msg.(tea.KeyMsg); ok {
+		if result := cmd(); result != nil {
+			if _, isTick := result.(pollMsg); !isTick {
+				next, _ := m.Update(result)
+				m = next.(model)
+			}
+		}
+	}
+	return m
+}
+
+func TestModel_PlayAgainstOpponent(t *testing.T) {
+	client := newTestClient(t)
+	alice := newModel(client, "alice", time.Second, time.Millisecond)
+	bob := newModel(client, "bob", time.Second, time.Millisecond)
+
+	// Alice starts a 4x4 game and waits for an opponent.
+	alice = press(t, alice, "n", "backspace", "4", "enter")
+	require.Equal(t, screenGame, alice.screen)
+	assert.Equal(t, pb.GameStatus_PENDING, alice.game.Status)
+	assert.Contains(t, alice.View(), "Waiting for an opponent")
+
+	// Bob finds it in the pending list and joins.
+	bob = press(t, bob, "s")
+	require.Equal(t, screenPending, bob.screen)
+	require.Len(t, bob.pending, 1)
+	bob = press(t, bob, "enter")
+	require.Equal(t, screenGame, bob.screen)
+	assert.Equal(t, pb.GameStatus_IN_PROGRESS, bob.game.Status)
+
+	// Alice picks up the join on her next poll and moves with the cursor.
+	alice = pollOnce(t, alice)
+	assert.Contains(t, alice.View(), "Your turn")
+	alice = press(t, alice, "right", "down", "enter")
+	assert.Equal(t, "X", alice.game.Board[1*4+1])
+
+	// Bob sees Alice's move arrive and answers with coordinates.
+	bob = pollOnce(t, bob)
+	assert.Equal(t, 1*4+1, bob.lastMove)
+	bob = press(t, bob, "3", " ", "4", "enter")
+	assert.Equal(t, "O", bob.game.Board[2*4+3])
+
+	// Moving out of turn is rejected locally.
+	bob = press(t, bob, "enter")
+	assert.True(t, bob.isError)
+	assert.Contains(t, bob.status, "Wait for your opponent")
+}
+
+func TestModel_Stats(t *testing.T) {
+	m := newModel(newTestClient(t), "carol", time.Second, time.Millisecond)
+
+	m = press(t, m, "t")
+	require.Equal(t, screenStats, m.screen)
+	assert.Contains(t, m.View(), "Statistics for carol")
+
+	m = press(t, m, "esc")
+	assert.Equal(t, screenMenu, m.screen)
+}
+
+func TestRenderBoard(t *testing.T) {
+	game := &pb.Game{
+		BoardSize: 5,
+		Board:     make([]string, 25),
+		Status:    pb.GameStatus_FINISHED_WIN,
+	}
+	game.Board[0] = "X"
+	game.Board[24] = "O"
+
+	lines := strings.Split(strings.TrimRight(renderBoard(game, 0, 0, -1), "\n"), "\n")
+	require.Len(t, lines, 6)
+	assert.Equal(t, "    1  2  3  4  5 ", lines[0])
+	assert.Equal(t, "  1 X  ·  ·  ·  · ", lines[1])
+	assert.Equal(t, "  5 ·  ·  ·  ·  O ", lines[5])
+}
+
+func TestRenderBoard_Rectangular(t *testing.T) {
+	game := &pb.Game{
+		BoardWidth:  4,
+		BoardHeight: 2,
+		Board:       make([]string, 8),
+		Status:      pb.GameStatus_FINISHED_WIN,
+	}
+	game.Board[3] = "X"
+	game.Board[4] = "O"
+
+	lines := strings.Split(strings.TrimRight(renderBoard(game, 0, 0, -1), "\n"), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, "    1  2  3  4 ", lines[0])
+	assert.Equal(t, "  1 ·  ·  ·  X ", lines[1])
+	assert.Equal(t, "  2 O  ·  ·  · ", lines[2])
+}
+
+func TestRenderBoard_Blocked(t *testing.T) {
+	game := &pb.Game{BoardSize: 3, Board: []string{"#", "", "X", "", "#", "", "O", "", ""}}
+
+	lines := strings.Split(strings.TrimRight(renderBoard(game, 0, 0, -1), "\n"), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, "  1 #  ·  X ", lines[1])
+	assert.Equal(t, "  2 ·  #  · ", lines[2])
+}
+
+func TestRenderPlayers(t *testing.T) {
+	assert.Equal(t, "X alice vs O ?", renderPlayers(&pb.Game{Player1Id: "alice"}))
+	assert.Equal(t, "X alice vs O bob", renderPlayers(&pb.Game{Player1Id: "alice", Player2Id: "bob"}))
+
+	game := &pb.Game{Player1Id: "alice", Player2Id: "bob", PlayerIds: []string{"alice", "bob"}, Seats: 4}
+	assert.Equal(t, "X alice vs O bob vs Y ? vs Z ?", renderPlayers(game))
+}
+
+func TestParseCoords(t *testing.T) {
+	row, col, ok := parseCoords("2 3", 3, 3)
+	assert.True(t, ok)
+	assert.Equal(t, 1, row)
+	assert.Equal(t, 2, col)
+
+	_, _, ok = parseCoords("4 1", 3, 3)
+	assert.False(t, ok)
+	_, _, ok = parseCoords("2", 3, 3)
+	assert.False(t, ok)
+
+	row, col, ok = parseCoords("6 7", 7, 6)
+	assert.True(t, ok)
+	assert.Equal(t, 5, row)
+	assert.Equal(t, 6, col)
+	_, _, ok = parseCoords("7 6", 7, 6)
+	assert.False(t, ok)
+}
+
+func pollOnce(t *testing.T, m model) model {
+	t.Helper()
+	next, cmd := m.Update(pollMsg{seq: m.pollSeq})
+	m = next.(model)
+	require.NotNil(t, cmd)
+	for _, c := range cmd().(tea.BatchMsg) {
+		if msg := c(); msg != nil {
+			if _, isTick := msg.(pollMsg); !isTick {
+				next, _ := m.Update(msg)
+				m = next.(model)
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,268 @@
+// cmd/ttt/view.go
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	pb "tictactoe/proto"
+)
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("13"))
+	helpStyle     = lipgloss.NewStyle().Faint(true)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	infoStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	selectedStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("14"))
+	xStyle        = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("9"))
+	oStyle        = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	yStyle        = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("11"))
+	zStyle        = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("13"))
+	emptyStyle    = lipgloss.NewStyle().Faint(true)
+	cursorStyle   = lipgloss.NewStyle().Reverse(true)
+	lastMoveStyle = lipgloss.NewStyle().Underline(true)
+)
+
+func (m model) View() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Tic-Tac-Toe") + helpStyle.Render("  playing as "+m.userID) + "\n\n")
+
+	switch m.screen {
+	case screenMenu:
+		m.viewMenu(&b)
+	case screenNewGame:
+		m.viewNewGame(&b)
+	case screenPending:
+		m.viewPending(&b)
+	case screenGame:
+		m.viewGame(&b)
+	case screenStats:
+		m.viewStats(&b)
+	}
+
+	if m.status != "" {
+		style := infoStyle
+		if m.isError {
+			style = errorStyle
+		}
+		b.WriteString("\n" + style.Render(m.status) + "\n")
+	}
+	return b.String()
+}
+
+func (m model) viewMenu(b *strings.Builder) {
+	for i, item := range m.menuItems() {
+		line := fmt.Sprintf("[%s] %s", item.key, item.label)
+		if i == m.menuCursor%len(m.menuItems()) {
+			line = selectedStyle.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n" + helpStyle.Render("↑/↓ select • enter confirm • q quit") + "\n")
+}
+
+func (m model) viewNewGame(b *strings.Builder) {
+	fields := []struct {
+		label string
+		value string
+	}{
+		{"Board size", m.sizeInput},
+		{"Winning length", m.winInput},
+	}
+	for i, f := range fields {
+		line := fmt.Sprintf("%-15s %s", f.label+":", f.value)
+		if i == m.inputField {
+			line = selectedStyle.Render("> " + line + "_")
+		} else {
+			line = "  " + line
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n" + helpStyle.Render("tab switch field • enter start (or match a waiting game) • esc back") + "\n")
+}
+
+func (m model) viewPending(b *strings.Builder) {
+	if len(m.pending) == 0 {
+		b.WriteString("No games are waiting for an opponent.\n")
+	}
+	for i, g := range m.pending {
+		width, height := boardDimensions(g.BoardWidth, g.BoardHeight, g.BoardSize)
+		line := fmt.Sprintf("%-12s %2dx%-2d %d in a row  %s  %s",
+			g.CreatorId, width, height, g.WinningLength,
+			time.Unix(g.CreatedAt, 0).Format(time.Kitchen), shortID(g.GameId))
+		if g.Seats > 2 {
+			line += fmt.Sprintf("  %d/%d players", g.Seats-g.OpenSeats, g.Seats)
+		}
+		if i == m.pendingCursor {
+			line = selectedStyle.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n" + helpStyle.Render("↑/↓ select • enter join • r refresh • esc back") + "\n")
+}
+
+func (m model) viewGame(b *strings.Builder) {
+	g := m.game
+	width, height := boardDimensions(g.BoardWidth, g.BoardHeight, g.BoardSize)
+	fmt.Fprintf(b, "Game %s  %dx%d, %d in a row\n", shortID(g.Id), width, height, g.WinningLength)
+	b.WriteString(renderPlayers(g) + "\n\n")
+
+	b.WriteString(renderBoard(g, m.cursorRow, m.cursorCol, m.lastMove))
+	b.WriteString("\n")
+
+	switch g.Status {
+	case pb.GameStatus_PENDING:
+		if g.Seats > 2 {
+			fmt.Fprintf(b, "Waiting for %d more players to join...\n", int(g.Seats)-len(seatedPlayers(g)))
+		} else {
+			b.WriteString("Waiting for an opponent to join...\n")
+		}
+	case pb.GameStatus_IN_PROGRESS:
+		if g.CurrentPlayerId == m.userID {
+			b.WriteString(infoStyle.Render("Your turn") + "\n")
+		} else {
+			b.WriteString("Waiting for " + g.CurrentPlayerId + "...\n")
+		}
+	case pb.GameStatus_FINISHED_WIN:
+		if g.WinnerId == m.userID {
+			b.WriteString(infoStyle.Render("You won!") + "\n")
+		} else {
+			b.WriteString(errorStyle.Render(g.WinnerId+" won.") + "\n")
+		}
+	case pb.GameStatus_FINISHED_DRAW:
+		b.WriteString("Draw.\n")
+	case pb.GameStatus_ABANDONED:
+		b.WriteString("The game was abandoned.\n")
+	}
+
+	if m.coords != "" {
+		b.WriteString("Move to (row col): " + m.coords + "_\n")
+	}
+	b.WriteString("\n" + helpStyle.Render("arrows/hjkl move • enter place • type \"row col\" + enter • r refresh • esc menu") + "\n")
+}
+
+func (m model) viewStats(b *strings.Builder) {
+	s := m.stats
+	fmt.Fprintf(b, "Statistics for %s\n\n", s.UserId)
+	fmt.Fprintf(b, "  Wins:   %d\n  Losses: %d\n  Draws:  %d\n  Total:  %d\n", s.Wins, s.Losses, s.Draws, s.TotalGames)
+	if s.TotalGames > 0 {
+		fmt.Fprintf(b, "  Win rate: %.0f%%\n", 100*float64(s.Wins)/float64(s.TotalGames))
+	}
+	if len(s.Variants) > 1 {
+		b.WriteString("\n  By variant:\n")
+		for _, name := range slices.Sorted(maps.Keys(s.Variants)) {
+			v := s.Variants[name]
+			fmt.Fprintf(b, "    %s: %d wins, %d losses, %d draws\n", name, v.Wins, v.Losses, v.Draws)
+		}
+	}
+	b.WriteString("\n" + helpStyle.Render("esc back") + "\n")
+}
+
+// renderBoard draws the board with 1-based row and column labels, marking
+// the cursor and the most recently changed cell.
+func renderBoard(g *pb.Game, cursorRow, cursorCol, lastMove int) string {
+	width, height := boardDimensions(g.BoardWidth, g.BoardHeight, g.BoardSize)
+	var b strings.Builder
+
+	b.WriteString("   ")
+	for col := 0; col < width; col++ {
+		fmt.Fprintf(&b, "%2d ", col+1)
+	}
+	b.WriteString("\n")
+
+	for row := 0; row < height; row++ {
+		fmt.Fprintf(&b, "%3d", row+1)
+		for col := 0; col < width; col++ {
+			i := row*width + col
+			var cell string
+			if i < len(g.Board) {
+				cell = g.Board[i]
+			}
+
+			style := symbolStyle(cell)
+			if cell == "" {
+				cell = "·"
+			}
+			if i == lastMove {
+				style = style.Inherit(lastMoveStyle)
+			}
+			text := " " + style.Render(cell) + " "
+			if row == cursorRow && col == cursorCol && g.Status == pb.GameStatus_IN_PROGRESS {
+				text = cursorStyle.Render(" " + cell + " ")
+			}
+			b.WriteString(text)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// playerSymbols are the symbols of each seat, in turn order.
+var playerSymbols = []string{"X", "O", "Y", "Z"}
+
+// symbolStyle returns the style a board cell holding symbol is drawn in.
+func symbolStyle(symbol string) lipgloss.Style {
+	switch symbol {
+	case "X":
+		return xStyle
+	case "O":
+		return oStyle
+	case "Y":
+		return yStyle
+	case "Z":
+		return zStyle
+	default:
+		return emptyStyle
+	}
+}
+
+// seatedPlayers returns the players in turn order, falling back to the two
+// player fields for servers that do not report player_ids.
+func seatedPlayers(g *pb.Game) []string {
+	if len(g.PlayerIds) > 0 {
+		return g.PlayerIds
+	}
+	if g.Player2Id == "" {
+		return []string{g.Player1Id}
+	}
+	return []string{g.Player1Id, g.Player2Id}
+}
+
+// renderPlayers lists every seat with its symbol, showing "?" for seats
+// still open.
+func renderPlayers(g *pb.Game) string {
+	players := seatedPlayers(g)
+	seats := max(int(g.Seats), 2, len(players))
+	parts := make([]string, 0, seats)
+	for i := 0; i < seats && i < len(playerSymbols); i++ {
+		player := "?"
+		if i < len(players) {
+			player = players[i]
+		}
+		parts = append(parts, symbolStyle(playerSymbols[i]).Render(playerSymbols[i])+" "+player)
+	}
+	return strings.Join(parts, " vs ")
+}
+
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
+
+func orDefault(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
@@ -0,0 +1,236 @@
+// cmd/tttadmin/commands.go
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "tictactoe/proto"
+)
+
+func (c *cli) run(ctx context.Context, command string, args []string) error {
+	switch command {
+	case "games":
+		return c.listGames(ctx, args)
+	case "end-game":
+		return c.endGame(ctx, args)
+	case "delete-game":
+		return c.deleteGame(ctx, args)
+	case "reset-stats":
+		return c.resetStats(ctx, args)
+	case "adjust-stats":
+		return c.adjustStats(ctx, args)
+	case "stats":
+		return c.stats(ctx)
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+func (c *cli) listGames(ctx context.Context, args []string) error {
+	flags := newFlagSet("games")
+	status := flags.String("status", "", "only games in this status (pending, in_progress, finished_win, finished_draw, abandoned)")
+	player := flags.String("player", "", "only games with this player")
+	boardSize := flags.Int("board-size", 0, "only games on square boards of this size")
+	boardWidth := flags.Int("board-width", 0, "only games with this board width")
+	boardHeight := flags.Int("board-height", 0, "only games with this board height")
+	winningLength := flags.Int("winning-length", 0, "only games with this winning length")
+	olderThan := flags.Duration("older-than", 0, "only games created at least this long ago")
+	newerThan := flags.Duration("newer-than", 0, "only games created at most this long ago")
+	limit := flags.Int("limit", 50, "maximum number of games; 0 lists all")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	req := &pb.ListGamesRequest{
+		PlayerId:      *player,
+		BoardSize:     int32(*boardSize),
+		BoardWidth:    int32(*boardWidth),
+		BoardHeight:   int32(*boardHeight),
+		WinningLength: int32(*winningLength),
+		MinAgeSeconds: int64(olderThan.Seconds()),
+		MaxAgeSeconds: int64(newerThan.Seconds()),
+		Limit:         int32(*limit),
+	}
+	if *status != "" {
+		value, ok := pb.GameStatus_value[strings.ToUpper(*status)]
+		if !ok {
+			return fmt.Errorf("unknown status %q", *status)
+		}
+		req.Status = pb.GameStatus(value).Enum()
+	}
+
+	resp, err := c.client.ListGames(ctx, req)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(resp)
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSTATUS\tPLAYERS\tBOARD\tAGE")
+	for _, g := range resp.Games {
+		players := g.Player1Id + " vs " + orDash(g.Player2Id)
+		if len(g.PlayerIds) > 2 {
+			players = strings.Join(g.PlayerIds, " vs ")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%dx%d/%d\t%s\n",
+			g.Id, g.Status, players,
+			g.BoardWidth, g.BoardHeight, g.WinningLength,
+			time.Since(time.Unix(g.CreatedAt, 0)).Round(time.Second))
+	}
+	return w.Flush()
+}
+
+func (c *cli) endGame(ctx context.Context, args []string) error {
+	flags := newFlagSet("end-game")
+	winner := flags.String("winner", "", "declare this player the winner")
+	draw := flags.Bool("draw", false, "declare a draw")
+	gameID, err := parseWithArg(flags, args, "game ID")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.ForceEndGame(ctx, &pb.ForceEndGameRequest{GameId: gameID, WinnerId: *winner, Draw: *draw})
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(resp)
+	}
+	fmt.Fprintf(c.out, "Game %s is now %s\n", resp.Game.Id, resp.Game.Status)
+	return nil
+}
+
+func (c *cli) deleteGame(ctx context.Context, args []string) error {
+	gameID, err := parseWithArg(newFlagSet("delete-game"), args, "game ID")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.DeleteGame(ctx, &pb.DeleteGameRequest{GameId: gameID})
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(resp)
+	}
+	fmt.Fprintf(c.out, "Deleted game %s\n", gameID)
+	return nil
+}
+
+func (c *cli) resetStats(ctx context.Context, args []string) error {
+	userID, err := parseWithArg(newFlagSet("reset-stats"), args, "user ID")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.ResetUserStats(ctx, &pb.ResetUserStatsRequest{UserId: userID})
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(resp)
+	}
+	printUserStats(c.out, resp.Stats)
+	return nil
+}
+
+func (c *cli) adjustStats(ctx context.Context, args []string) error {
+	flags := newFlagSet("adjust-stats")
+	wins := flags.Int("wins", 0, "wins to add (negative to subtract)")
+	losses := flags.Int("losses", 0, "losses to add (negative to subtract)")
+	draws := flags.Int("draws", 0, "draws to add (negative to subtract)")
+	userID, err := parseWithArg(flags, args, "user ID")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.AdjustUserStats(ctx, &pb.AdjustUserStatsRequest{
+		UserId:      userID,
+		WinsDelta:   int32(*wins),
+		LossesDelta: int32(*losses),
+		DrawsDelta:  int32(*draws),
+	})
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(resp)
+	}
+	printUserStats(c.out, resp.Stats)
+	return nil
+}
+
+func (c *cli) stats(ctx context.Context) error {
+	resp, err := c.client.Stats(ctx, &pb.StatsRequest{})
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJSON(resp)
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Uptime:\t%s\n", time.Duration(resp.UptimeSeconds)*time.Second)
+	fmt.Fprintf(w, "Connected streams:\t%d\n", resp.ConnectedStreams)
+	fmt.Fprintf(w, "Games:\t%d\n", resp.TotalGames)
+
+	statuses := make([]string, 0, len(resp.GamesByStatus))
+	for status := range resp.GamesByStatus {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return pb.GameStatus_value[statuses[i]] < pb.GameStatus_value[statuses[j]]
+	})
+	for _, status := range statuses {
+		fmt.Fprintf(w, "  %s:\t%d\n", status, resp.GamesByStatus[status])
+	}
+	return w.Flush()
+}
+
+func (c *cli) printJSON(m proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true, UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(c.out, string(data))
+	return err
+}
+
+func printUserStats(w io.Writer, s *pb.UserStats) {
+	fmt.Fprintf(w, "%s: %d wins, %d losses, %d draws (%d games)\n", s.UserId, s.Wins, s.Losses, s.Draws, s.TotalGames)
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet(name, flag.ContinueOnError)
+}
+
+// parseWithArg parses flags followed by exactly one positional argument.
+func parseWithArg(flags *flag.FlagSet, args []string, what string) (string, error) {
+	if err := flags.Parse(args); err != nil {
+		return "", err
+	}
+	if flags.NArg() != 1 {
+		return "", errors.New(flags.Name() + ": expected a " + what)
+	}
+	return flags.Arg(0), nil
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
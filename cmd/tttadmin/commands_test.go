@@ -0,0 +1,93 @@
+// cmd/tttadmin/commands_test.go
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"tictactoe/internal/adapters/grpc/handler"
+	"tictactoe/internal/adapters/repository"
+	"tictactoe/internal/application/service"
+	"tictactoe/internal/domain/config"
+	"tictactoe/internal/domain/entity"
+	pb "tictactoe/proto"
+)
+
+func newTestCLI(t *testing.T) (*cli, *bytes.Buffer, string) {
+	t.Helper()
+
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	gameService := service.NewGameService(gameRepo, userRepo, config.DefaultConfig())
+	ctx := context.Background()
+	game, err := gameService.StartGame(ctx, "alice", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+	_, err = gameService.JoinGame(ctx, "bob", game.ID)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	pb.RegisterAdminServiceServer(server, handler.NewAdminHandler(service.NewAdminService(gameRepo, userRepo)))
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	out := &bytes.Buffer{}
+	return &cli{client: pb.NewAdminServiceClient(conn), out: out}, out, game.ID
+}
+
+func TestCLI_ListAndEndGame(t *testing.T) {
+	c, out, gameID := newTestCLI(t)
+	ctx := context.Background()
+
+	require.NoError(t, c.run(ctx, "games", []string{"-status", "in_progress"}))
+	assert.Contains(t, out.String(), gameID)
+	assert.Contains(t, out.String(), "alice vs bob")
+
+	out.Reset()
+	require.NoError(t, c.run(ctx, "end-game", []string{"-draw", gameID}))
+	assert.Equal(t, "Game "+gameID+" is now FINISHED_DRAW\n", out.String())
+
+	out.Reset()
+	require.NoError(t, c.run(ctx, "adjust-stats", []string{"-wins", "2", "alice"}))
+	assert.Equal(t, "alice: 2 wins, 0 losses, 1 draws (3 games)\n", out.String())
+
+	out.Reset()
+	require.NoError(t, c.run(ctx, "stats", nil))
+	assert.Contains(t, out.String(), "FINISHED_DRAW:")
+}
+
+func TestCLI_JSONOutput(t *testing.T) {
+	c, out, _ := newTestCLI(t)
+	c.json = true
+
+	require.NoError(t, c.run(context.Background(), "reset-stats", []string{"bob"}))
+
+	var resp pb.ResetUserStatsResponse
+	require.NoError(t, protojson.Unmarshal(out.Bytes(), &resp))
+	assert.Equal(t, "bob", resp.Stats.UserId)
+}
+
+func TestCLI_Errors(t *testing.T) {
+	c, _, _ := newTestCLI(t)
+	ctx := context.Background()
+
+	assert.EqualError(t, c.run(ctx, "nope", nil), `unknown command "nope"`)
+	assert.EqualError(t, c.run(ctx, "games", []string{"-status", "bogus"}), `unknown status "bogus"`)
+	assert.EqualError(t, c.run(ctx, "delete-game", nil), "delete-game: expected a game ID")
+}
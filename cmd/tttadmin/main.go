@@ -0,0 +1,77 @@
+// cmd/tttadmin/main.go
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "tictactoe/proto"
+)
+
+const usage = `Usage: tttadmin [flags] <command> [command flags] [args]
+
+Commands:
+  games                                    list games, newest first
+  end-game [-winner user | -draw] <game>   force-end a game (abandons it by default)
+  delete-game <game>                       delete a game
+  reset-stats <user>                       reset a user's statistics
+  adjust-stats [-wins n] [-losses n] [-draws n] <user>
+                                           add (or subtract) from a user's statistics
+  stats                                    show server statistics
+
+Flags:
+`
+
+func main() {
+	flags := flag.NewFlagSet("tttadmin", flag.ExitOnError)
+	addr := flags.String("addr", "localhost:8080", "gRPC server address")
+	token := flags.String("token", os.Getenv("ADMIN_TOKEN"), "admin credential (defaults to $ADMIN_TOKEN)")
+	timeout := flags.Duration("timeout", 10*time.Second, "request timeout")
+	asJSON := flags.Bool("json", false, "print responses as JSON")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(os.Args[1:])
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tttadmin: %v\n", err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+
+	c := &cli{client: pb.NewAdminServiceClient(conn), out: os.Stdout, json: *asJSON}
+	if err := c.run(ctx, flags.Arg(0), flags.Args()[1:]); err != nil {
+		if st, ok := status.FromError(err); ok {
+			err = fmt.Errorf("%s: %s", st.Code(), st.Message())
+		}
+		fmt.Fprintf(os.Stderr, "tttadmin: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// cli runs one admin command against client, writing the result to out.
+type cli struct {
+	client pb.AdminServiceClient
+	out    io.Writer
+	json   bool
+}
@@ -0,0 +1,84 @@
+// internal/adapters/connectapi/cors.go
+package connectapi
+
+import (
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/rs/cors"
+)
+
+// CORSConfig lists the browser origins allowed to call the service.
+type CORSConfig struct {
+	AllowedOrigins []string
+}
+
+// CORSConfigFromEnv reads a comma-separated origin list from
+// CORS_ALLOWED_ORIGINS. "*" allows any origin; an empty value disables
+// cross-origin access.
+func CORSConfigFromEnv() CORSConfig {
+	var origins []string
+	for _, origin := range strings.Split(os.Getenv("CORS_ALLOWED_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return CORSConfig{AllowedOrigins: origins}
+}
+
+// AllowsOrigin reports whether a browser at origin may make cross-origin
+// requests, matching origins the way the CORS middleware does: "*" allows
+// any origin and an entry may hold one "*" wildcard, as in
+// "https://*.example.com".
+func (c CORSConfig) AllowsOrigin(origin string) bool {
+	origin = strings.ToLower(origin)
+	for _, allowed := range c.AllowedOrigins {
+		allowed = strings.ToLower(allowed)
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+		prefix, suffix, found := strings.Cut(allowed, "*")
+		if found && len(origin) >= len(prefix)+len(suffix) &&
+			strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedHeaders are the request headers used by Connect and gRPC-Web
+// clients, plus the request ID and trace context headers.
+var allowedHeaders = []string{
+	"Content-Type",
+	"Connect-Protocol-Version",
+	"Connect-Timeout-Ms",
+	"Grpc-Timeout",
+	"X-Grpc-Web",
+	"X-User-Agent",
+	"X-Request-Id",
+	"Traceparent",
+	"Tracestate",
+}
+
+// exposedHeaders are the response headers browsers must be allowed to read
+// for gRPC-Web status handling and request ID correlation.
+var exposedHeaders = []string{
+	"Grpc-Status",
+	"Grpc-Message",
+	"Grpc-Status-Details-Bin",
+	"X-Request-Id",
+}
+
+func withCORS(next http.Handler, cfg CORSConfig) http.Handler {
+	if len(cfg.AllowedOrigins) == 0 {
+		return next
+	}
+	return cors.New(cors.Options{
+		AllowedOrigins: cfg.AllowedOrigins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders: allowedHeaders,
+		ExposedHeaders: exposedHeaders,
+		MaxAge:         7200,
+	}).Handler(next)
+}
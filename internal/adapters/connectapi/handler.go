@@ -0,0 +1,185 @@
+// internal/adapters/connectapi/handler.go
+package connectapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"tictactoe/internal/adapters/grpc/interceptor"
+	pb "tictactoe/proto"
+	"tictactoe/proto/protoconnect"
+)
+
+// NewHandler returns an HTTP handler serving TicTacToeService over the
+// Connect and gRPC-Web protocols, for both HTTP/1.1 and HTTP/2. Calls are
+// forwarded to conn so that they go through the same interceptors as
+// native gRPC clients. Cross-origin requests are allowed for the origins in
+// cfg.
+func NewHandler(conn *grpc.ClientConn, cfg CORSConfig) http.Handler {
+	path, handler := protoconnect.NewTicTacToeServiceHandler(&service{client: pb.NewTicTacToeServiceClient(conn)})
+
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	return withCORS(mux, cfg)
+}
+
+// service implements protoconnect.TicTacToeServiceHandler on top of a
+// gRPC client.
+type service struct {
+	client pb.TicTacToeServiceClient
+}
+
+func (s *service) StartGame(ctx context.Context, req *connect.Request[pb.StartGameRequest]) (*connect.Response[pb.StartGameResponse], error) {
+	return forward(ctx, req, s.client.StartGame)
+}
+
+func (s *service) SearchPendingGames(ctx context.Context, req *connect.Request[pb.SearchPendingGamesRequest]) (*connect.Response[pb.SearchPendingGamesResponse], error) {
+	return forward(ctx, req, s.client.SearchPendingGames)
+}
+
+func (s *service) JoinGame(ctx context.Context, req *connect.Request[pb.JoinGameRequest]) (*connect.Response[pb.JoinGameResponse], error) {
+	return forward(ctx, req, s.client.JoinGame)
+}
+
+func (s *service) MakeMove(ctx context.Context, req *connect.Request[pb.MakeMoveRequest]) (*connect.Response[pb.MakeMoveResponse], error) {
+	return forward(ctx, req, s.client.MakeMove)
+}
+
+func (s *service) GetGame(ctx context.Context, req *connect.Request[pb.GetGameRequest]) (*connect.Response[pb.GetGameResponse], error) {
+	return forward(ctx, req, s.client.GetGame)
+}
+
+func (s *service) GetUserStats(ctx context.Context, req *connect.Request[pb.GetUserStatsRequest]) (*connect.Response[pb.GetUserStatsResponse], error) {
+	return forward(ctx, req, s.client.GetUserStats)
+}
+
+func (s *service) ListUserGames(ctx context.Context, req *connect.Request[pb.ListUserGamesRequest]) (*connect.Response[pb.ListUserGamesResponse], error) {
+	return forward(ctx, req, s.client.ListUserGames)
+}
+
+func (s *service) SpectateGame(ctx context.Context, req *connect.Request[pb.SpectateGameRequest], stream *connect.ServerStream[pb.SpectateGameResponse]) error {
+	return forwardStream(ctx, req, stream, s.client.SpectateGame)
+}
+
+func (s *service) SetSpectatorsAllowed(ctx context.Context, req *connect.Request[pb.SetSpectatorsAllowedRequest]) (*connect.Response[pb.SetSpectatorsAllowedResponse], error) {
+	return forward(ctx, req, s.client.SetSpectatorsAllowed)
+}
+
+func (s *service) ListLiveGames(ctx context.Context, req *connect.Request[pb.ListLiveGamesRequest]) (*connect.Response[pb.ListLiveGamesResponse], error) {
+	return forward(ctx, req, s.client.ListLiveGames)
+}
+
+func (s *service) OfferRematch(ctx context.Context, req *connect.Request[pb.OfferRematchRequest]) (*connect.Response[pb.OfferRematchResponse], error) {
+	return forward(ctx, req, s.client.OfferRematch)
+}
+
+func (s *service) AcceptRematch(ctx context.Context, req *connect.Request[pb.AcceptRematchRequest]) (*connect.Response[pb.AcceptRematchResponse], error) {
+	return forward(ctx, req, s.client.AcceptRematch)
+}
+
+func (s *service) DeclineRematch(ctx context.Context, req *connect.Request[pb.DeclineRematchRequest]) (*connect.Response[pb.DeclineRematchResponse], error) {
+	return forward(ctx, req, s.client.DeclineRematch)
+}
+
+func (s *service) OfferDraw(ctx context.Context, req *connect.Request[pb.OfferDrawRequest]) (*connect.Response[pb.OfferDrawResponse], error) {
+	return forward(ctx, req, s.client.OfferDraw)
+}
+
+func (s *service) AcceptDraw(ctx context.Context, req *connect.Request[pb.AcceptDrawRequest]) (*connect.Response[pb.AcceptDrawResponse], error) {
+	return forward(ctx, req, s.client.AcceptDraw)
+}
+
+func (s *service) DeclineDraw(ctx context.Context, req *connect.Request[pb.DeclineDrawRequest]) (*connect.Response[pb.DeclineDrawResponse], error) {
+	return forward(ctx, req, s.client.DeclineDraw)
+}
+
+func (s *service) RequestTakeback(ctx context.Context, req *connect.Request[pb.RequestTakebackRequest]) (*connect.Response[pb.RequestTakebackResponse], error) {
+	return forward(ctx, req, s.client.RequestTakeback)
+}
+
+func (s *service) RespondTakeback(ctx context.Context, req *connect.Request[pb.RespondTakebackRequest]) (*connect.Response[pb.RespondTakebackResponse], error) {
+	return forward(ctx, req, s.client.RespondTakeback)
+}
+
+// forward invokes call with the request message, propagating the trace
+// context and request ID in both directions and translating gRPC status
+// errors into Connect errors.
+func forward[Req, Res any](
+	ctx context.Context,
+	req *connect.Request[Req],
+	call func(context.Context, *Req, ...grpc.CallOption) (*Res, error),
+) (*connect.Response[Res], error) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(req.Header()))
+	if requestID := req.Header().Get(interceptor.RequestIDHeader); requestID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, interceptor.RequestIDHeader, requestID)
+	}
+
+	var header metadata.MD
+	res, err := call(ctx, req.Msg, grpc.Header(&header))
+	if err != nil {
+		connectErr := connectError(err)
+		setRequestID(connectErr.Meta(), header)
+		return nil, connectErr
+	}
+
+	resp := connect.NewResponse(res)
+	setRequestID(resp.Header(), header)
+	return resp, nil
+}
+
+// forwardStream is forward for server-streaming calls: it relays every
+// message of the gRPC stream until it ends.
+func forwardStream[Req, Res any](
+	ctx context.Context,
+	req *connect.Request[Req],
+	stream *connect.ServerStream[Res],
+	call func(context.Context, *Req, ...grpc.CallOption) (grpc.ServerStreamingClient[Res], error),
+) error {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(req.Header()))
+	if requestID := req.Header().Get(interceptor.RequestIDHeader); requestID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, interceptor.RequestIDHeader, requestID)
+	}
+
+	upstream, err := call(ctx, req.Msg)
+	if err != nil {
+		return connectError(err)
+	}
+	if header, err := upstream.Header(); err == nil {
+		setRequestID(stream.ResponseHeader(), header)
+	}
+
+	for {
+		res, err := upstream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return connectError(err)
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
+
+// connectError converts a gRPC status error into a Connect error with the
+// same code and message. The numeric code values of both protocols match.
+func connectError(err error) *connect.Error {
+	st := status.Convert(err)
+	return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+}
+
+func setRequestID(h http.Header, md metadata.MD) {
+	if values := md.Get(interceptor.RequestIDHeader); len(values) > 0 {
+		h.Set(interceptor.RequestIDHeader, values[0])
+	}
+}
@@ -0,0 +1,119 @@
+// internal/adapters/grpc/handler/admin_handler.go
+package handler
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tictactoe/internal/domain/port"
+	pb "tictactoe/proto"
+)
+
+// AdminHandler serves AdminService. It performs no authorization itself;
+// register it behind interceptor.UnaryBearerAuth.
+type AdminHandler struct {
+	pb.UnimplementedAdminServiceServer
+	adminService port.AdminService
+	options
+}
+
+func NewAdminHandler(adminService port.AdminService, opts ...Option) *AdminHandler {
+	return &AdminHandler{
+		adminService: adminService,
+		options:      newOptions(opts),
+	}
+}
+
+func (h *AdminHandler) ListGames(ctx context.Context, req *pb.ListGamesRequest) (*pb.ListGamesResponse, error) {
+	filter := port.GameFilter{
+		PlayerID:      req.PlayerId,
+		BoardSize:     int(req.BoardSize),
+		BoardWidth:    int(req.BoardWidth),
+		BoardHeight:   int(req.BoardHeight),
+		WinningLength: int(req.WinningLength),
+		Limit:         int(req.Limit),
+	}
+	if req.Status != nil {
+		status := mapGameStatusFromProto(req.GetStatus())
+		filter.Status = &status
+	}
+	now := time.Now()
+	if req.MinAgeSeconds > 0 {
+		filter.CreatedBefore = now.Add(-time.Duration(req.MinAgeSeconds) * time.Second)
+	}
+	if req.MaxAgeSeconds > 0 {
+		filter.CreatedAfter = now.Add(-time.Duration(req.MaxAgeSeconds) * time.Second)
+	}
+
+	games, err := h.adminService.ListGames(ctx, filter)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	resp := &pb.ListGamesResponse{Games: make([]*pb.Game, 0, len(games))}
+	for _, game := range games {
+		resp.Games = append(resp.Games, h.mapGame(game))
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) ForceEndGame(ctx context.Context, req *pb.ForceEndGameRequest) (*pb.ForceEndGameResponse, error) {
+	if req.WinnerId != "" && req.Draw {
+		return nil, status.Error(codes.InvalidArgument, "winner_id and draw are mutually exclusive")
+	}
+
+	game, err := h.adminService.ForceEndGame(ctx, req.GameId, req.WinnerId, req.Draw)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.ForceEndGameResponse{Game: h.mapGame(game)}, nil
+}
+
+func (h *AdminHandler) DeleteGame(ctx context.Context, req *pb.DeleteGameRequest) (*pb.DeleteGameResponse, error) {
+	if err := h.adminService.DeleteGame(ctx, req.GameId); err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.DeleteGameResponse{}, nil
+}
+
+func (h *AdminHandler) ResetUserStats(ctx context.Context, req *pb.ResetUserStatsRequest) (*pb.ResetUserStatsResponse, error) {
+	stats, err := h.adminService.ResetUserStats(ctx, req.UserId)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.ResetUserStatsResponse{Stats: mapUserStatsToProto(stats)}, nil
+}
+
+func (h *AdminHandler) AdjustUserStats(ctx context.Context, req *pb.AdjustUserStatsRequest) (*pb.AdjustUserStatsResponse, error) {
+	stats, err := h.adminService.AdjustUserStats(ctx, req.UserId,
+		int(req.WinsDelta), int(req.LossesDelta), int(req.DrawsDelta))
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.AdjustUserStatsResponse{Stats: mapUserStatsToProto(stats)}, nil
+}
+
+func (h *AdminHandler) Stats(ctx context.Context, req *pb.StatsRequest) (*pb.StatsResponse, error) {
+	stats, err := h.adminService.ServerStats(ctx)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	resp := &pb.StatsResponse{
+		TotalGames:       stats.Games,
+		GamesByStatus:    make(map[string]int64, len(stats.GamesByStatus)),
+		ConnectedStreams: int64(stats.ConnectedStreams),
+		UptimeSeconds:    int64(stats.Uptime / time.Second),
+	}
+	for status, count := range stats.GamesByStatus {
+		resp.GamesByStatus[mapGameStatusToProto(status).String()] = count
+	}
+	return resp, nil
+}
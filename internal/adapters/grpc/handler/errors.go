@@ -0,0 +1,81 @@
+// internal/adapters/grpc/handler/errors.go
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tictactoe/internal/domain/config"
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+// statusError maps a service error to a gRPC status so that every transport
+// (native gRPC, the REST gateway) reports it consistently.
+// See https://grpc.io/docs/guides/status-codes/
+func (o options) statusError(ctx context.Context, err error) error {
+	code := ErrorCode(err)
+	if code == codes.Internal {
+		o.logger.ErrorContext(ctx, "unexpected service error", slog.String("error", err.Error()))
+		return status.Error(code, "internal server error")
+	}
+	return status.Error(code, err.Error())
+}
+
+// ErrorCode returns the gRPC code a service error is reported with. Other
+// transports translate it rather than keeping their own table.
+func ErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, entity.ErrGameNotFound),
+		errors.Is(err, entity.ErrUserNotFound):
+		return codes.NotFound
+	case errors.Is(err, entity.ErrPlayerNotInGame),
+		errors.Is(err, entity.ErrNotInvited),
+		errors.Is(err, entity.ErrNotGameCreator),
+		errors.Is(err, entity.ErrSpectatingClosed):
+		return codes.PermissionDenied
+	case errors.Is(err, entity.ErrInvalidMove),
+		errors.Is(err, entity.ErrInvalidAdjustment),
+		errors.Is(err, entity.ErrUnknownVariant),
+		errors.Is(err, entity.ErrInvalidSeats),
+		errors.Is(err, entity.ErrInvalidPlayer),
+		errors.Is(err, entity.ErrInvalidPlacement),
+		errors.Is(err, config.ErrBoardTooLarge),
+		errors.Is(err, port.ErrInvalidPageToken):
+		return codes.InvalidArgument
+	case errors.Is(err, entity.ErrGameFull),
+		errors.Is(err, entity.ErrNotPlayersTurn),
+		errors.Is(err, entity.ErrGameFinished),
+		errors.Is(err, entity.ErrGameNotStarted),
+		errors.Is(err, entity.ErrPositionOccupied),
+		errors.Is(err, entity.ErrCellBlocked),
+		errors.Is(err, entity.ErrForbiddenMove),
+		errors.Is(err, entity.ErrColumnFull),
+		errors.Is(err, entity.ErrWrongLocalBoard),
+		errors.Is(err, entity.ErrLocalBoardDecided),
+		errors.Is(err, entity.ErrTwoPlayerOnly),
+		errors.Is(err, entity.ErrGameNotFinished),
+		errors.Is(err, entity.ErrRematchOffered),
+		errors.Is(err, entity.ErrNoRematchOffer),
+		errors.Is(err, entity.ErrDrawOffered),
+		errors.Is(err, entity.ErrNoDrawOffer),
+		errors.Is(err, entity.ErrTakebacksNotAllowed),
+		errors.Is(err, entity.ErrTakebackLimit),
+		errors.Is(err, entity.ErrTakebackRequested),
+		errors.Is(err, entity.ErrNoTakebackRequest),
+		errors.Is(err, entity.ErrNothingToTakeBack):
+		return codes.FailedPrecondition
+	case errors.Is(err, port.ErrConcurrentUpdate):
+		return codes.Aborted
+	default:
+		return codes.Internal
+	}
+}
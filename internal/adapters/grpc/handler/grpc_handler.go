@@ -3,104 +3,213 @@ package handler
 
 import (
 	"context"
-	"errors"
-	"tictactoe/internal/domain/entity"
-	"tictactoe/internal/domain/port"
-	pb "tictactoe/proto"
+	"log/slog"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"tictactoe/internal/adapters/pubsub"
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+	pb "tictactoe/proto"
 )
 
 type GRPCHandler struct {
 	pb.UnimplementedTicTacToeServiceServer
 	gameService port.GameService
+	options
+
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+// options holds the settings shared by the handlers in this package.
+type options struct {
+	logger *slog.Logger
+	broker *pubsub.Broker
+}
+
+type Option func(*options)
+
+// WithLogger sets the logger used by the handler. Defaults to slog.Default().
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithBroker sets the source of live game updates for SpectateGame and of
+// spectator counts. It must be the broker the game service publishes to;
+// without it SpectateGame is unavailable and games show no spectators.
+func WithBroker(broker *pubsub.Broker) Option {
+	return func(o *options) {
+		o.broker = broker
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{logger: slog.Default()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// mapGame converts a game for the wire with its current spectator count.
+func (o options) mapGame(game *entity.Game) *pb.Game {
+	msg := MapGameToProto(game)
+	if o.broker != nil {
+		msg.SpectatorCount = int32(o.broker.SpectatorCount(game.ID))
+	}
+	return msg
 }
 
-func NewGRPCHandler(gameService port.GameService) *GRPCHandler {
+func NewGRPCHandler(gameService port.GameService, opts ...Option) *GRPCHandler {
 	return &GRPCHandler{
 		gameService: gameService,
+		options:     newOptions(opts),
+		closing:     make(chan struct{}),
 	}
 }
 
+// Close ends every open SpectateGame stream, e.g. on server shutdown, so that
+// a graceful stop does not wait for them.
+func (h *GRPCHandler) Close() {
+	h.closeOnce.Do(func() { close(h.closing) })
+}
+
 func (h *GRPCHandler) StartGame(ctx context.Context, req *pb.StartGameRequest) (*pb.StartGameResponse, error) {
-	boardSize := int(req.BoardSize)
-	winningLength := int(req.WinningLength)
+	settings := entity.GameSettings{
+		BoardSize:       int(req.BoardSize),
+		BoardWidth:      int(req.BoardWidth),
+		BoardHeight:     int(req.BoardHeight),
+		WinningLength:   int(req.WinningLength),
+		Variant:         req.Variant,
+		Seats:           int(req.Seats),
+		Obstacles:       mapPositionsFromProto(req.Obstacles),
+		RandomObstacles: int(req.RandomObstacles),
+		HandicapStones:  mapPositionsFromProto(req.HandicapStones),
+		Ranked:          req.Ranked,
+	}
 
-	game, err := h.gameService.StartGame(req.UserId, boardSize, winningLength)
+	var game *entity.Game
+	var err error
+	if req.Private || req.InvitedUserId != "" {
+		game, err = h.gameService.StartPrivateGame(ctx, req.UserId, settings, req.InvitedUserId)
+	} else {
+		game, err = h.gameService.StartGame(ctx, req.UserId, settings)
+	}
 	if err != nil {
-		//TODO: handle grpc status codes properly https://grpc.io/docs/guides/status-codes/
-		return &pb.StartGameResponse{
-			Status:  mapGameStatusToProto(game.Status),
-			Message: err.Error(),
-		}, nil
+		return nil, h.statusError(ctx, err)
 	}
 
 	var message string
-	if game.Status == entity.StatusPending {
+	switch {
+	case game.Private:
+		message = "Private game created. Share the join code with your opponent."
+	case game.Status == entity.StatusPending && game.Player1ID != req.UserId:
+		message = "Joined existing game. Waiting for more players."
+	case game.Status == entity.StatusPending && game.Seats > 2:
+		message = "Game created. Waiting for players."
+	case game.Status == entity.StatusPending:
 		message = "Game created. Waiting for opponent."
-	} else {
+	default:
 		message = "Joined existing game. Game started!"
 	}
 
 	return &pb.StartGameResponse{
-		GameId:  game.ID,
-		Status:  mapGameStatusToProto(game.Status),
-		Message: message,
+		GameId:   game.ID,
+		Status:   mapGameStatusToProto(game.Status),
+		Message:  message,
+		JoinCode: game.JoinCode,
 	}, nil
 }
 
 func (h *GRPCHandler) SearchPendingGames(ctx context.Context, req *pb.SearchPendingGamesRequest) (*pb.SearchPendingGamesResponse, error) {
-	boardSize := int(req.BoardSize)
-	winningLength := int(req.WinningLength)
+	query := port.PendingGameQuery{
+		Filter: port.PendingGameFilter{
+			BoardSize:        int(req.BoardSize),
+			BoardWidth:       int(req.BoardWidth),
+			BoardHeight:      int(req.BoardHeight),
+			WinningLength:    int(req.WinningLength),
+			Variant:          req.Variant,
+			Seats:            int(req.Seats),
+			CreatorID:        req.CreatorId,
+			ExcludeCreatorID: req.UserId,
+			Ranked:           req.Ranked,
+		},
+		Order:     mapPendingGameOrderFromProto(req.OrderBy),
+		PageToken: req.PageToken,
+		PageSize:  int(req.PageSize),
+	}
+	if req.MaxAgeSeconds > 0 {
+		query.Filter.CreatedAfter = time.Now().Add(-time.Duration(req.MaxAgeSeconds) * time.Second)
+	}
 
-	games, err := h.gameService.SearchPendingGames(boardSize, winningLength)
+	page, err := h.gameService.SearchPendingGames(ctx, query)
 	if err != nil {
-		//TODO: handle grpc status codes properly https://grpc.io/docs/guides/status-codes/
-		return nil, err
+		return nil, h.statusError(ctx, err)
 	}
 
 	var pbGames []*pb.PendingGame
-	for _, game := range games {
+	for _, game := range page.Games {
 		pbGames = append(pbGames, &pb.PendingGame{
-			GameId:        game.ID,
-			CreatorId:     game.Player1ID,
-			BoardSize:     int32(game.BoardSize),
-			WinningLength: int32(game.WinningLength),
-			CreatedAt:     game.CreatedAt.Unix(),
+			GameId:         game.ID,
+			CreatorId:      game.Player1ID,
+			BoardSize:      squareBoardSize(game),
+			BoardWidth:     int32(game.BoardWidth),
+			BoardHeight:    int32(game.BoardHeight),
+			WinningLength:  int32(game.WinningLength),
+			CreatedAt:      game.CreatedAt.Unix(),
+			Variant:        game.Variant,
+			Seats:          int32(game.Seats),
+			OpenSeats:      int32(game.OpenSeats()),
+			Obstacles:      int32(len(game.Obstacles())),
+			HandicapStones: int32(len(game.HandicapStones)),
+			Ranked:         game.Ranked,
 		})
 	}
 
 	return &pb.SearchPendingGamesResponse{
-		Games: pbGames,
+		Games:         pbGames,
+		NextPageToken: page.NextPageToken,
+		TotalSize:     int32(page.TotalSize),
 	}, nil
 }
 
 func (h *GRPCHandler) JoinGame(ctx context.Context, req *pb.JoinGameRequest) (*pb.JoinGameResponse, error) {
-	game, err := h.gameService.JoinGame(req.UserId, req.GameId)
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	var game *entity.Game
+	var err error
+	if req.JoinCode != "" {
+		if req.GameId != "" {
+			return nil, status.Error(codes.InvalidArgument, "game_id and join_code are mutually exclusive")
+		}
+		game, err = h.gameService.JoinGameByCode(ctx, req.UserId, req.JoinCode)
+	} else {
+		game, err = h.gameService.JoinGame(ctx, req.UserId, req.GameId)
+	}
 	if err != nil {
-		//TODO: handle grpc status codes properly https://grpc.io/docs/guides/status-codes/
-		return &pb.JoinGameResponse{
-			Status:  pb.GameStatus_PENDING,
-			Message: err.Error(),
-		}, nil
+		return nil, h.statusError(ctx, err)
 	}
 
 	return &pb.JoinGameResponse{
 		Status:  mapGameStatusToProto(game.Status),
-		Game:    mapGameToProto(game),
+		Game:    h.mapGame(game),
 		Message: "Successfully joined game!",
 	}, nil
 }
 
 func (h *GRPCHandler) MakeMove(ctx context.Context, req *pb.MakeMoveRequest) (*pb.MakeMoveResponse, error) {
-	game, err := h.gameService.MakeMove(req.UserId, req.GameId, int(req.Row), int(req.Col))
+	game, err := h.gameService.MakeMove(ctx, req.UserId, req.GameId, int(req.Row), int(req.Col))
 	if err != nil {
-		//TODO: handle grpc status codes properly https://grpc.io/docs/guides/status-codes/
-		return &pb.MakeMoveResponse{
-			Status:  pb.GameStatus_IN_PROGRESS,
-			Message: err.Error(),
-		}, nil
+		return nil, h.statusError(ctx, err)
 	}
 
 	var message string
@@ -119,57 +228,219 @@ func (h *GRPCHandler) MakeMove(ctx context.Context, req *pb.MakeMoveRequest) (*p
 
 	return &pb.MakeMoveResponse{
 		Status:  mapGameStatusToProto(game.Status),
-		Game:    mapGameToProto(game),
+		Game:    h.mapGame(game),
 		Message: message,
 	}, nil
 }
 
 func (h *GRPCHandler) GetGame(ctx context.Context, req *pb.GetGameRequest) (*pb.GetGameResponse, error) {
-	game, err := h.gameService.GetGame(req.GameId, req.UserId)
-	if err != nil {
-		// Map service errors to gRPC status codeshttps://grpc.io/docs/guides/status-codes/
-		var grpcErr error
-		switch {
-		case errors.Is(err, entity.ErrGameNotFound): // Example custom error
-			grpcErr = status.Errorf(codes.NotFound, "Game not found: %v", err)
-		case errors.Is(err, entity.ErrPlayerNotInGame): // Example custom error
-			grpcErr = status.Errorf(codes.PermissionDenied, "Unauthorized access: %v", err)
-		default:
-			grpcErr = status.Errorf(codes.Internal, "Internal server error: %v", err)
-		}
-		return nil, grpcErr
+	game, err := h.gameService.GetGame(ctx, req.GameId, req.UserId)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
 	}
 
 	return &pb.GetGameResponse{
-		Game: mapGameToProto(game),
+		Game: h.mapGame(game),
 	}, nil
 }
 
 func (h *GRPCHandler) GetUserStats(ctx context.Context, req *pb.GetUserStatsRequest) (*pb.GetUserStatsResponse, error) {
-	stats, err := h.gameService.GetUserStats(req.UserId)
-	if err != nil {
-		// Map service errors to gRPC status codes https://grpc.io/docs/guides/status-codes/
-		var grpcErr error
-		switch {
-		case errors.Is(err, entity.ErrUserNotFound): // Example custom error
-			grpcErr = status.Errorf(codes.NotFound, "User not found: %v", err)
-		default:
-			grpcErr = status.Errorf(codes.Internal, "Internal server error: %v", err)
-		}
-		return nil, grpcErr
+	stats, err := h.gameService.GetUserStats(ctx, req.UserId)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
 	}
 
 	return &pb.GetUserStatsResponse{
-		Stats: &pb.UserStats{
-			UserId:     stats.UserID,
-			Wins:       int32(stats.Wins),
-			Losses:     int32(stats.Losses),
-			Draws:      int32(stats.Draws),
-			TotalGames: int32(stats.TotalGames),
-		},
+		Stats: mapUserStatsToProto(stats),
 	}, nil
 }
 
+func (h *GRPCHandler) ListUserGames(ctx context.Context, req *pb.ListUserGamesRequest) (*pb.ListUserGamesResponse, error) {
+	statuses := make([]entity.GameStatus, 0, len(req.StatusFilter))
+	for _, status := range req.StatusFilter {
+		statuses = append(statuses, mapGameStatusFromProto(status))
+	}
+
+	page, err := h.gameService.ListUserGames(ctx, req.UserId, statuses, req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	resp := &pb.ListUserGamesResponse{
+		Games:         make([]*pb.UserGame, 0, len(page.Games)),
+		NextPageToken: page.NextPageToken,
+	}
+	for _, game := range page.Games {
+		resp.Games = append(resp.Games, &pb.UserGame{
+			Game:     h.mapGame(game),
+			YourTurn: game.IsTurnOf(req.UserId),
+		})
+	}
+	return resp, nil
+}
+
+func (h *GRPCHandler) SpectateGame(req *pb.SpectateGameRequest, stream pb.TicTacToeService_SpectateGameServer) error {
+	ctx := stream.Context()
+	if h.broker == nil {
+		return status.Error(codes.Unimplemented, "live game updates are not enabled")
+	}
+
+	// Subscribe before reading the snapshot so no update falls in between
+	sub := h.broker.Subscribe(req.GameId)
+	defer sub.Close()
+
+	game, err := h.gameService.SpectateGame(ctx, req.GameId, req.UserId)
+	if err != nil {
+		return h.statusError(ctx, err)
+	}
+	defer func() {
+		// The stream is over whatever the reason, so record it even if the
+		// client has gone away
+		if err := h.gameService.StopSpectating(context.WithoutCancel(ctx), req.GameId, req.UserId); err != nil {
+			h.logger.WarnContext(ctx, "failed to remove spectator",
+				slog.String("game_id", req.GameId),
+				slog.String("user_id", req.UserId),
+				slog.String("error", err.Error()))
+		}
+	}()
+
+	var sent *pb.Game
+	for {
+		msg := h.mapGame(game)
+		if !game.IsPlayerInGame(req.UserId) {
+			// The join code would let spectators take the open seat
+			msg.JoinCode = ""
+		}
+		// Skip updates that change nothing visible
+		if !proto.Equal(msg, sent) {
+			if err := stream.Send(&pb.SpectateGameResponse{Game: msg}); err != nil {
+				return err
+			}
+			sent = msg
+		}
+		// Players keep watching a finished game until the rematch is settled
+		// so that both hear of an offer and its answer
+		if game.IsFinished() && (!game.IsPlayerInGame(req.UserId) || game.RematchSettled()) {
+			return nil
+		}
+
+		select {
+		case <-h.closing:
+			return status.Error(codes.Unavailable, "server shutting down")
+		case <-ctx.Done():
+			return h.statusError(ctx, ctx.Err())
+		case update, ok := <-sub.C:
+			if !ok {
+				return status.Error(codes.Unavailable, "subscription closed")
+			}
+			game = update
+		}
+
+		// The creator may close the game to spectators at any time
+		if !game.CanSpectate(req.UserId) {
+			return h.statusError(ctx, entity.ErrSpectatingClosed)
+		}
+	}
+}
+
+func (h *GRPCHandler) SetSpectatorsAllowed(ctx context.Context, req *pb.SetSpectatorsAllowedRequest) (*pb.SetSpectatorsAllowedResponse, error) {
+	game, err := h.gameService.SetSpectatorsAllowed(ctx, req.UserId, req.GameId, req.Allowed)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.SetSpectatorsAllowedResponse{Game: h.mapGame(game)}, nil
+}
+
+func (h *GRPCHandler) ListLiveGames(ctx context.Context, req *pb.ListLiveGamesRequest) (*pb.ListLiveGamesResponse, error) {
+	page, err := h.gameService.ListLiveGames(ctx, req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	resp := &pb.ListLiveGamesResponse{
+		Games:         make([]*pb.Game, 0, len(page.Games)),
+		NextPageToken: page.NextPageToken,
+		TotalSize:     int32(page.TotalSize),
+	}
+	for _, game := range page.Games {
+		resp.Games = append(resp.Games, h.mapGame(game))
+	}
+	return resp, nil
+}
+
+func (h *GRPCHandler) OfferRematch(ctx context.Context, req *pb.OfferRematchRequest) (*pb.OfferRematchResponse, error) {
+	game, err := h.gameService.OfferRematch(ctx, req.UserId, req.GameId)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.OfferRematchResponse{Game: h.mapGame(game)}, nil
+}
+
+func (h *GRPCHandler) AcceptRematch(ctx context.Context, req *pb.AcceptRematchRequest) (*pb.AcceptRematchResponse, error) {
+	game, err := h.gameService.AcceptRematch(ctx, req.UserId, req.GameId)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.AcceptRematchResponse{Game: h.mapGame(game)}, nil
+}
+
+func (h *GRPCHandler) DeclineRematch(ctx context.Context, req *pb.DeclineRematchRequest) (*pb.DeclineRematchResponse, error) {
+	game, err := h.gameService.DeclineRematch(ctx, req.UserId, req.GameId)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.DeclineRematchResponse{Game: h.mapGame(game)}, nil
+}
+
+func (h *GRPCHandler) OfferDraw(ctx context.Context, req *pb.OfferDrawRequest) (*pb.OfferDrawResponse, error) {
+	game, err := h.gameService.OfferDraw(ctx, req.UserId, req.GameId)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.OfferDrawResponse{Game: h.mapGame(game)}, nil
+}
+
+func (h *GRPCHandler) AcceptDraw(ctx context.Context, req *pb.AcceptDrawRequest) (*pb.AcceptDrawResponse, error) {
+	game, err := h.gameService.AcceptDraw(ctx, req.UserId, req.GameId)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.AcceptDrawResponse{Game: h.mapGame(game)}, nil
+}
+
+func (h *GRPCHandler) DeclineDraw(ctx context.Context, req *pb.DeclineDrawRequest) (*pb.DeclineDrawResponse, error) {
+	game, err := h.gameService.DeclineDraw(ctx, req.UserId, req.GameId)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.DeclineDrawResponse{Game: h.mapGame(game)}, nil
+}
+
+func (h *GRPCHandler) RequestTakeback(ctx context.Context, req *pb.RequestTakebackRequest) (*pb.RequestTakebackResponse, error) {
+	game, err := h.gameService.RequestTakeback(ctx, req.UserId, req.GameId)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.RequestTakebackResponse{Game: h.mapGame(game)}, nil
+}
+
+func (h *GRPCHandler) RespondTakeback(ctx context.Context, req *pb.RespondTakebackRequest) (*pb.RespondTakebackResponse, error) {
+	game, err := h.gameService.RespondTakeback(ctx, req.UserId, req.GameId, req.Accept)
+	if err != nil {
+		return nil, h.statusError(ctx, err)
+	}
+
+	return &pb.RespondTakebackResponse{Game: h.mapGame(game)}, nil
+}
+
 // Helper functions for mapping between domain and protobuf types
 
 func mapGameStatusToProto(status entity.GameStatus) pb.GameStatus {
@@ -189,18 +460,194 @@ func mapGameStatusToProto(status entity.GameStatus) pb.GameStatus {
 	}
 }
 
-func mapGameToProto(game *entity.Game) *pb.Game {
+func mapGameStatusFromProto(status pb.GameStatus) entity.GameStatus {
+	switch status {
+	case pb.GameStatus_IN_PROGRESS:
+		return entity.StatusInProgress
+	case pb.GameStatus_FINISHED_WIN:
+		return entity.StatusFinishedWin
+	case pb.GameStatus_FINISHED_DRAW:
+		return entity.StatusFinishedDraw
+	case pb.GameStatus_ABANDONED:
+		return entity.StatusAbandoned
+	default:
+		return entity.StatusPending
+	}
+}
+
+func mapPendingGameOrderFromProto(order pb.PendingGameOrder) port.PendingGameOrder {
+	switch order {
+	case pb.PendingGameOrder_ORDER_NEWEST_FIRST:
+		return port.OrderNewestFirst
+	case pb.PendingGameOrder_ORDER_CREATOR_WINS:
+		return port.OrderCreatorWins
+	default:
+		return port.OrderOldestFirst
+	}
+}
+
+func mapUserStatsToProto(stats *entity.UserStats) *pb.UserStats {
+	variants := make(map[string]*pb.VariantStats, len(stats.Variants))
+	for variant, v := range stats.Variants {
+		variants[variant] = &pb.VariantStats{
+			Wins:       int32(v.Wins),
+			Losses:     int32(v.Losses),
+			Draws:      int32(v.Draws),
+			TotalGames: int32(v.TotalGames),
+		}
+	}
+	return &pb.UserStats{
+		UserId:     stats.UserID,
+		Wins:       int32(stats.Wins),
+		Losses:     int32(stats.Losses),
+		Draws:      int32(stats.Draws),
+		TotalGames: int32(stats.TotalGames),
+		Variants:   variants,
+	}
+}
+
+// squareBoardSize is the board_size reported for game, which is only set
+// for square boards.
+func squareBoardSize(game *entity.Game) int32 {
+	if !game.IsSquare() {
+		return 0
+	}
+	return int32(game.BoardWidth)
+}
+
+// MapGameToProto converts a game to its wire representation, shared by every
+// transport that exposes games. The spectator count is not part of the game
+// and is left for callers to fill in.
+func MapGameToProto(game *entity.Game) *pb.Game {
 	return &pb.Game{
-		Id:              game.ID,
-		Player1Id:       game.Player1ID,
-		Player2Id:       game.Player2ID,
-		Board:           game.FlattenBoard(),
-		BoardSize:       int32(game.BoardSize),
-		WinningLength:   int32(game.WinningLength),
-		Status:          mapGameStatusToProto(game.Status),
-		CurrentPlayerId: game.CurrentPlayer,
-		WinnerId:        game.WinnerID,
-		CreatedAt:       game.CreatedAt.Unix(),
-		UpdatedAt:       game.UpdatedAt.Unix(),
+		Id:                  game.ID,
+		Player1Id:           game.Player1ID,
+		Player2Id:           game.Player2ID,
+		Board:               game.FlattenBoard(),
+		BoardSize:           squareBoardSize(game),
+		BoardWidth:          int32(game.BoardWidth),
+		BoardHeight:         int32(game.BoardHeight),
+		WinningLength:       int32(game.WinningLength),
+		Variant:             game.Variant,
+		Status:              mapGameStatusToProto(game.Status),
+		CurrentPlayerId:     game.CurrentPlayer,
+		WinnerId:            game.WinnerID,
+		CreatedAt:           game.CreatedAt.Unix(),
+		UpdatedAt:           game.UpdatedAt.Unix(),
+		Private:             game.Private,
+		JoinCode:            game.JoinCode,
+		InvitedUserId:       game.InvitedUserID,
+		SpectatorsAllowed:   game.SpectatorsAllowed,
+		Rematch:             mapRematchToProto(game.Rematch),
+		DrawOfferedBy:       game.DrawOfferedBy,
+		Ranked:              game.Ranked,
+		Moves:               mapMovesToProto(game.Moves),
+		TakebackRequestedBy: game.TakebackRequestedBy,
+		Takebacks:           mapTakebacksToProto(game.Takebacks),
+		Ultimate:            mapUltimateToProto(game),
+		PlayerIds:           game.Players,
+		Seats:               int32(game.Seats),
+		HandicapStones:      mapPositionsToProto(game.HandicapStones),
+	}
+}
+
+func mapPositionsFromProto(positions []*pb.Position) []entity.Position {
+	if len(positions) == 0 {
+		return nil
+	}
+	mapped := make([]entity.Position, len(positions))
+	for i, pos := range positions {
+		mapped[i] = entity.Position{Row: int(pos.Row), Col: int(pos.Col)}
+	}
+	return mapped
+}
+
+func mapPositionsToProto(positions []entity.Position) []*pb.Position {
+	if len(positions) == 0 {
+		return nil
+	}
+	mapped := make([]*pb.Position, len(positions))
+	for i, pos := range positions {
+		mapped[i] = &pb.Position{Row: int32(pos.Row), Col: int32(pos.Col)}
+	}
+	return mapped
+}
+
+func mapUltimateToProto(game *entity.Game) *pb.UltimateState {
+	// Games of an unregistered variant report no ultimate state either
+	variantRules, err := game.Rules()
+	if err != nil {
+		return nil
+	}
+	rules, ok := variantRules.(entity.UltimateRules)
+	if !ok {
+		return nil
+	}
+	meta := rules.MetaBoard(game)
+	state := &pb.UltimateState{}
+	for _, row := range meta {
+		state.MetaBoard = append(state.MetaBoard, row...)
+	}
+	if forced, ok := rules.ForcedBoard(game); ok && !game.IsFinished() {
+		state.ForcedBoard = proto.Int32(int32(forced.Row*len(meta) + forced.Col))
+	}
+	return state
+}
+
+func mapMovesToProto(moves []entity.Move) []*pb.Move {
+	if len(moves) == 0 {
+		return nil
+	}
+	pbMoves := make([]*pb.Move, 0, len(moves))
+	for _, move := range moves {
+		pbMoves = append(pbMoves, &pb.Move{
+			PlayerId: move.PlayerID,
+			Row:      int32(move.Position.Row),
+			Col:      int32(move.Position.Col),
+			PlayedAt: move.PlayedAt.Unix(),
+		})
+	}
+	return pbMoves
+}
+
+func mapTakebacksToProto(takebacks []entity.Takeback) []*pb.Takeback {
+	if len(takebacks) == 0 {
+		return nil
+	}
+	pbTakebacks := make([]*pb.Takeback, 0, len(takebacks))
+	for _, takeback := range takebacks {
+		pbTakebacks = append(pbTakebacks, &pb.Takeback{
+			RequestedBy: takeback.RequestedBy,
+			Moves:       mapMovesToProto(takeback.Moves),
+			GrantedAt:   takeback.GrantedAt.Unix(),
+		})
+	}
+	return pbTakebacks
+}
+
+func mapRematchToProto(rematch entity.Rematch) *pb.Rematch {
+	if rematch.Status == entity.RematchNone {
+		return nil
+	}
+	return &pb.Rematch{
+		Status:    mapRematchStatusToProto(rematch.Status),
+		OfferedBy: rematch.OfferedBy,
+		ExpiresAt: rematch.ExpiresAt.Unix(),
+		GameId:    rematch.GameID,
+	}
+}
+
+func mapRematchStatusToProto(status entity.RematchStatus) pb.RematchStatus {
+	switch status {
+	case entity.RematchOffered:
+		return pb.RematchStatus_REMATCH_OFFERED
+	case entity.RematchAccepted:
+		return pb.RematchStatus_REMATCH_ACCEPTED
+	case entity.RematchDeclined:
+		return pb.RematchStatus_REMATCH_DECLINED
+	case entity.RematchExpired:
+		return pb.RematchStatus_REMATCH_EXPIRED
+	default:
+		return pb.RematchStatus_REMATCH_NONE
 	}
 }
@@ -0,0 +1,60 @@
+// internal/adapters/grpc/interceptor/auth.go
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryBearerAuth requires calls to the given services (fully-qualified
+// names, e.g. "tictactoe.AdminService") to carry
+// "authorization: Bearer <token>". Calls to other services pass through.
+func UnaryBearerAuth(token string, services ...string) grpc.UnaryServerInterceptor {
+	protected := make(map[string]bool, len(services))
+	for _, service := range services {
+		protected[service] = true
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !protected[serviceName(info.FullMethod)] {
+			return handler(ctx, req)
+		}
+
+		presented, ok := bearerToken(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+		if subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+			return nil, status.Error(codes.PermissionDenied, "invalid credentials")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// serviceName extracts "pkg.Service" from "/pkg.Service/Method".
+func serviceName(fullMethod string) string {
+	name := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get("authorization") {
+		if token, found := strings.CutPrefix(value, "Bearer "); found && token != "" {
+			return token, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,117 @@
+// internal/adapters/grpc/interceptor/logging.go
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"tictactoe/internal/adapters/logging"
+)
+
+// RequestIDHeader is the metadata key used to accept and echo request IDs.
+const RequestIDHeader = "x-request-id"
+
+type userIDGetter interface {
+	GetUserId() string
+}
+
+type gameIDGetter interface {
+	GetGameId() string
+}
+
+// UnaryLogging assigns a request ID to every call (reusing the caller's
+// x-request-id when present) and logs the outcome of the call.
+func UnaryLogging(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		requestID := incomingRequestID(ctx)
+		ctx = logging.ContextWithRequestID(ctx, requestID)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		attrs := []slog.Attr{
+			slog.String("method", info.FullMethod),
+			slog.String("code", code.String()),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if r, ok := req.(userIDGetter); ok && r.GetUserId() != "" {
+			attrs = append(attrs, slog.String("user_id", r.GetUserId()))
+		}
+		if r, ok := req.(gameIDGetter); ok && r.GetGameId() != "" {
+			attrs = append(attrs, slog.String("game_id", r.GetGameId()))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+
+		logger.LogAttrs(ctx, levelForCode(code), "rpc completed", attrs...)
+		return resp, err
+	}
+}
+
+// StreamLogging is UnaryLogging for streaming calls. The outcome is logged
+// when the stream ends.
+func StreamLogging(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		requestID := incomingRequestID(ss.Context())
+		ctx := logging.ContextWithRequestID(ss.Context(), requestID)
+		_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, requestID))
+
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+
+		code := status.Code(err)
+		attrs := []slog.Attr{
+			slog.String("method", info.FullMethod),
+			slog.String("code", code.String()),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+
+		logger.LogAttrs(ctx, levelForCode(code), "stream completed", attrs...)
+		return err
+	}
+}
+
+// contextStream overrides the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func incomingRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return uuid.New().String()
+}
+
+func levelForCode(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
@@ -0,0 +1,36 @@
+// internal/adapters/grpc/interceptor/metrics.go
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RPCObserver records the outcome of a gRPC call.
+type RPCObserver interface {
+	ObserveRPC(method, code string, duration time.Duration)
+}
+
+// UnaryMetrics reports the method, status code and latency of every call.
+func UnaryMetrics(observer RPCObserver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observer.ObserveRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return resp, err
+	}
+}
+
+// StreamMetrics is UnaryMetrics for streaming calls; the latency covers the
+// whole life of the stream.
+func StreamMetrics(observer RPCObserver) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observer.ObserveRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return err
+	}
+}
@@ -0,0 +1,87 @@
+// internal/adapters/health/monitor.go
+package health
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Pinger is implemented by every dependency whose availability decides
+// whether the server is ready, such as the repositories.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Check names a dependency for logging purposes.
+type Check struct {
+	Name   string
+	Pinger Pinger
+}
+
+// Monitor keeps the status of a grpc.health.v1 server in sync with the
+// health of the server's dependencies. The overall ("") status and every
+// registered service are SERVING only while all checks pass.
+type Monitor struct {
+	server   *health.Server
+	services []string
+	checks   []Check
+	timeout  time.Duration
+	logger   *slog.Logger
+}
+
+func NewMonitor(server *health.Server, services []string, checks []Check, logger *slog.Logger) *Monitor {
+	return &Monitor{
+		server:   server,
+		services: append([]string{""}, services...),
+		checks:   checks,
+		timeout:  2 * time.Second,
+		logger:   logger,
+	}
+}
+
+// CheckNow runs every check once and updates the serving status.
+func (m *Monitor) CheckNow(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	status := healthpb.HealthCheckResponse_SERVING
+	for _, check := range m.checks {
+		if err := check.Pinger.Ping(ctx); err != nil {
+			m.logger.WarnContext(ctx, "health check failed",
+				slog.String("check", check.Name),
+				slog.String("error", err.Error()))
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	for _, service := range m.services {
+		m.server.SetServingStatus(service, status)
+	}
+	return status
+}
+
+// Run checks the dependencies every interval until ctx is done.
+func (m *Monitor) Run(ctx context.Context, interval time.Duration) {
+	m.CheckNow(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.CheckNow(ctx)
+		}
+	}
+}
+
+// Shutdown reports NOT_SERVING for every service and ignores further
+// updates, so that load balancers drain the server before it stops.
+func (m *Monitor) Shutdown() {
+	m.server.Shutdown()
+}
@@ -0,0 +1,64 @@
+// internal/adapters/health/monitor_test.go
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"tictactoe/internal/adapters/logging"
+)
+
+type fakePinger struct {
+	err error
+}
+
+func (p *fakePinger) Ping(ctx context.Context) error {
+	return p.err
+}
+
+func servingStatus(t *testing.T, server *health.Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.Status
+}
+
+func TestMonitor_ReflectsDependencyHealth(t *testing.T) {
+	server := health.NewServer()
+	store := &fakePinger{}
+	monitor := NewMonitor(server, []string{"tictactoe.TicTacToeService"},
+		[]Check{{Name: "store", Pinger: store}}, logging.Discard())
+
+	monitor.CheckNow(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, "tictactoe.TicTacToeService"))
+
+	store.err = errors.New("store closed")
+	monitor.CheckNow(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, "tictactoe.TicTacToeService"))
+
+	store.err = nil
+	monitor.CheckNow(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, ""))
+}
+
+func TestMonitor_Shutdown(t *testing.T) {
+	server := health.NewServer()
+	monitor := NewMonitor(server, []string{"tictactoe.TicTacToeService"},
+		[]Check{{Name: "store", Pinger: &fakePinger{}}}, logging.Discard())
+	monitor.CheckNow(context.Background())
+
+	monitor.Shutdown()
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, "tictactoe.TicTacToeService"))
+
+	// Later checks must not flip the server back to SERVING while draining
+	monitor.CheckNow(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ""))
+}
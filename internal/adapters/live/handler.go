@@ -0,0 +1,250 @@
+// internal/adapters/live/handler.go
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"tictactoe/internal/adapters/connectapi"
+	"tictactoe/internal/adapters/grpc/handler"
+	"tictactoe/internal/adapters/pubsub"
+	"tictactoe/internal/adapters/rest"
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+const (
+	// Time allowed to write a message; a client that cannot keep up within
+	// this window is disconnected.
+	writeWait = 10 * time.Second
+	// Time allowed between pongs before the connection is considered dead.
+	pongWait = 60 * time.Second
+	// Pings are sent often enough to arrive before pongWait expires.
+	pingPeriod = pongWait * 9 / 10
+	// Client messages are tiny moves.
+	maxMessageSize = 1024
+	// Replies (errors) queued for a client before it is considered stuck.
+	outboxSize = 8
+)
+
+// Message is the JSON envelope exchanged over the socket.
+//
+// Server to client: {"type":"game","game":{...}} after every change and
+// {"type":"error","message":"..."} when a move is rejected.
+// Client to server: {"type":"move","row":0,"col":0}.
+type Message struct {
+	Type    string          `json:"type"`
+	Game    json.RawMessage `json:"game,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Row     int             `json:"row,omitempty"`
+	Col     int             `json:"col,omitempty"`
+}
+
+const (
+	TypeGame  = "game"
+	TypeError = "error"
+	TypeMove  = "move"
+)
+
+var gameMarshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// Handler serves a WebSocket per player and game at
+// GET /v1/games/{game_id}/live?user_id=...; callers identify themselves with
+// user_id exactly as in the gRPC API. Browsers may connect from the page's
+// own origin or from any origin the CORS configuration allows.
+type Handler struct {
+	gameService port.GameService
+	broker      *pubsub.Broker
+	upgrader    websocket.Upgrader
+	logger      *slog.Logger
+
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func NewHandler(gameService port.GameService, broker *pubsub.Broker, cors connectapi.CORSConfig, logger *slog.Logger) *Handler {
+	return &Handler{
+		gameService: gameService,
+		broker:      broker,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     checkOrigin(cors),
+		},
+		logger:  logger,
+		closing: make(chan struct{}),
+	}
+}
+
+// Close asks every open connection to go away, e.g. on server shutdown.
+func (h *Handler) Close() {
+	h.closeOnce.Do(func() { close(h.closing) })
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	gameID := r.PathValue("game_id")
+	userID := r.URL.Query().Get("user_id")
+	if userID == "" {
+		http.Error(w, "user_id is required", http.StatusBadRequest)
+		return
+	}
+
+	// Subscribe before reading the snapshot so no update falls in between
+	sub := h.broker.Subscribe(gameID)
+	defer sub.Close()
+
+	game, err := h.gameService.GetGame(r.Context(), gameID, userID)
+	if err != nil {
+		code, message := h.clientError(r.Context(), err)
+		http.Error(w, message, rest.HTTPStatusFromCode(code))
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already replied to the client
+		return
+	}
+	defer conn.Close()
+
+	logger := h.logger.With(slog.String("game_id", gameID), slog.String("user_id", userID))
+	logger.DebugContext(r.Context(), "live connection opened")
+
+	ctx, cancel := context.WithCancel(context.WithoutCancel(r.Context()))
+	defer cancel()
+
+	outbox := make(chan Message, outboxSize)
+	go h.readLoop(ctx, cancel, conn, gameID, userID, outbox)
+
+	err = h.writeLoop(ctx, conn, game, sub, outbox)
+	logger.DebugContext(ctx, "live connection closed", slog.Any("reason", err))
+}
+
+// readLoop handles client moves until the connection fails or ctx is done.
+func (h *Handler) readLoop(ctx context.Context, cancel context.CancelFunc, conn *websocket.Conn, gameID, userID string, outbox chan<- Message) {
+	defer cancel()
+
+	conn.SetReadLimit(maxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		var msg Message
+		if err := conn.ReadJSON(&msg); err != nil {
+			return
+		}
+
+		var reply *Message
+		switch msg.Type {
+		case TypeMove:
+			// The resulting board reaches every subscriber through the broker
+			if _, err := h.gameService.MakeMove(ctx, userID, gameID, msg.Row, msg.Col); err != nil {
+				_, message := h.clientError(ctx, err)
+				reply = &Message{Type: TypeError, Message: message}
+			}
+		default:
+			reply = &Message{Type: TypeError, Message: "unknown message type " + msg.Type}
+		}
+
+		if reply != nil {
+			select {
+			case outbox <- *reply:
+			default:
+				// The client is not draining its replies, drop it
+				return
+			}
+		}
+	}
+}
+
+// writeLoop is the only writer on conn: it sends the initial snapshot,
+// every update, queued replies and keepalive pings.
+func (h *Handler) writeLoop(ctx context.Context, conn *websocket.Conn, game *entity.Game, sub *pubsub.Subscription, outbox <-chan Message) error {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+
+	if err := h.writeGame(conn, game); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-h.closing:
+			conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"), time.Now().Add(writeWait))
+			return errors.New("server shutting down")
+		case <-ctx.Done():
+			conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(writeWait))
+			return ctx.Err()
+		case game, ok := <-sub.C:
+			if !ok {
+				return errors.New("subscription closed")
+			}
+			if err := h.writeGame(conn, game); err != nil {
+				return err
+			}
+		case msg := <-outbox:
+			conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteJSON(msg); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *Handler) writeGame(conn *websocket.Conn, game *entity.Game) error {
+	msg := handler.MapGameToProto(game)
+	msg.SpectatorCount = int32(h.broker.SpectatorCount(game.ID))
+	body, err := gameMarshaler.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return conn.WriteJSON(Message{Type: TypeGame, Game: body})
+}
+
+// clientError maps a service error to the code and message the gRPC API
+// would report, so that the socket and the other transports agree.
+// Unexpected errors are logged rather than shown to the client.
+func (h *Handler) clientError(ctx context.Context, err error) (codes.Code, string) {
+	code := handler.ErrorCode(err)
+	if code == codes.Internal {
+		h.logger.ErrorContext(ctx, "unexpected service error", slog.String("error", err.Error()))
+		return code, "internal server error"
+	}
+	return code, err.Error()
+}
+
+// checkOrigin accepts requests without an Origin header and same-origin
+// requests, as the websocket package does by default, plus the origins
+// allowed by cors.
+func checkOrigin(cors connectapi.CORSConfig) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+			return true
+		}
+		return cors.AllowsOrigin(origin)
+	}
+}
@@ -0,0 +1,186 @@
+// internal/adapters/live/handler_test.go
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"tictactoe/internal/adapters/connectapi"
+	"tictactoe/internal/adapters/logging"
+	"tictactoe/internal/adapters/pubsub"
+	"tictactoe/internal/adapters/repository"
+	"tictactoe/internal/application/service"
+	"tictactoe/internal/domain/config"
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+func setupLiveServer(t *testing.T) (*httptest.Server, port.GameService) {
+	t.Helper()
+
+	broker := pubsub.NewBroker()
+	svc := service.NewGameService(
+		repository.NewInMemoryGameRepository(),
+		repository.NewInMemoryUserRepository(),
+		config.DefaultConfig(),
+		service.WithEventPublisher(broker),
+		service.WithSpectatorTracker(broker),
+		service.WithLogger(logging.Discard()),
+	)
+
+	h := NewHandler(svc, broker, connectapi.CORSConfig{AllowedOrigins: []string{"https://*.example.com"}}, logging.Discard())
+	mux := http.NewServeMux()
+	mux.Handle("GET /v1/games/{game_id}/live", h)
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() {
+		h.Close()
+		server.Close()
+	})
+	return server, svc
+}
+
+func dial(t *testing.T, server *httptest.Server, gameID, userID string) *websocket.Conn {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/games/" + gameID + "/live?user_id=" + userID
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) (Message, map[string]any) {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+
+	var msg Message
+	require.NoError(t, conn.ReadJSON(&msg))
+
+	var game map[string]any
+	if msg.Type == TypeGame {
+		require.NoError(t, json.Unmarshal(msg.Game, &game))
+	}
+	return msg, game
+}
+
+func TestHandler_PushesMovesToBothPlayers(t *testing.T) {
+	server, svc := setupLiveServer(t)
+	ctx := context.Background()
+
+	game, err := svc.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+	_, err = svc.JoinGame(ctx, "player2", game.ID)
+	require.NoError(t, err)
+
+	p1 := dial(t, server, game.ID, "player1")
+	p2 := dial(t, server, game.ID, "player2")
+
+	// Both receive the current board on connect
+	_, snapshot := readMessage(t, p1)
+	assert.Equal(t, "IN_PROGRESS", snapshot["status"])
+	readMessage(t, p2)
+
+	// A move sent over the socket reaches the opponent
+	require.NoError(t, p1.WriteJSON(Message{Type: TypeMove, Row: 1, Col: 1}))
+	_, update := readMessage(t, p2)
+	assert.Equal(t, "X", update["board"].([]any)[4])
+	assert.Equal(t, "player2", update["current_player_id"])
+
+	// Moves made through other transports are pushed as well
+	_, err = svc.MakeMove(ctx, "player2", game.ID, 0, 0)
+	require.NoError(t, err)
+	_, update = readMessage(t, p1)
+	for update["current_player_id"] != "player1" {
+		_, update = readMessage(t, p1)
+	}
+	assert.Equal(t, "O", update["board"].([]any)[0])
+
+	// Rejected moves are reported to the sender only
+	require.NoError(t, p2.WriteJSON(Message{Type: TypeMove, Row: 2, Col: 2}))
+	msg, _ := readMessage(t, p2)
+	for msg.Type != TypeError {
+		msg, _ = readMessage(t, p2)
+	}
+	assert.Contains(t, msg.Message, "not player's turn")
+}
+
+func TestHandler_RejectsNonPlayers(t *testing.T) {
+	server, svc := setupLiveServer(t)
+
+	game, err := svc.StartGame(context.Background(), "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/games/" + game.ID + "/live?user_id=intruder"
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	url = "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/games/missing/live?user_id=player1"
+	_, resp, err = websocket.DefaultDialer.Dial(url, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestHandler_CheckOrigin(t *testing.T) {
+	server, svc := setupLiveServer(t)
+
+	game, err := svc.StartGame(context.Background(), "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/games/" + game.ID + "/live?user_id=player1"
+
+	tests := []struct {
+		origin  string
+		allowed bool
+	}{
+		{"", true},
+		{server.URL, true},
+		{"https://app.example.com", true},
+		{"https://example.com.evil.test", false},
+		{"https://evil.test", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.origin, func(t *testing.T) {
+			header := http.Header{}
+			if tt.origin != "" {
+				header.Set("Origin", tt.origin)
+			}
+			conn, resp, err := websocket.DefaultDialer.Dial(url, header)
+			if tt.allowed {
+				require.NoError(t, err)
+				conn.Close()
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandler_ClientError(t *testing.T) {
+	h := NewHandler(nil, nil, connectapi.CORSConfig{}, logging.Discard())
+	ctx := context.Background()
+
+	code, message := h.clientError(ctx, entity.ErrSpectatingClosed)
+	assert.Equal(t, codes.PermissionDenied, code)
+	assert.Equal(t, entity.ErrSpectatingClosed.Error(), message)
+
+	code, _ = h.clientError(ctx, entity.ErrInvalidMove)
+	assert.Equal(t, codes.InvalidArgument, code)
+	code, _ = h.clientError(ctx, context.Canceled)
+	assert.Equal(t, codes.Canceled, code)
+
+	// Unexpected errors are not shown to the client
+	code, message = h.clientError(ctx, errors.New("disk on fire"))
+	assert.Equal(t, codes.Internal, code)
+	assert.Equal(t, "internal server error", message)
+}
@@ -0,0 +1,47 @@
+// internal/adapters/logging/logging_test.go
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_JSONWithRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{Level: "debug", Format: FormatJSON}, &buf)
+	require.NoError(t, err)
+
+	ctx := ContextWithRequestID(context.Background(), "req-123")
+	logger.With("component", "test").DebugContext(ctx, "hello")
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "hello", record["msg"])
+	assert.Equal(t, "req-123", record["request_id"])
+	assert.Equal(t, "test", record["component"])
+}
+
+func TestNew_LevelFiltering(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{Level: "warn", Format: FormatText}, &buf)
+	require.NoError(t, err)
+
+	logger.Info("dropped")
+	assert.Empty(t, buf.String())
+
+	logger.Warn("kept")
+	assert.Contains(t, buf.String(), "msg=kept")
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	_, err := New(Config{Level: "loud"}, &bytes.Buffer{})
+	assert.Error(t, err)
+
+	_, err = New(Config{Level: "info", Format: "xml"}, &bytes.Buffer{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,156 @@
+// internal/adapters/metrics/metrics.go
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+const namespace = "tictactoe"
+
+// Metrics owns a dedicated Prometheus registry and every collector exposed by
+// the server. It implements port.GameMetrics.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcRequests      *prometheus.CounterVec
+	rpcDuration      *prometheus.HistogramVec
+	gamesFinished    *prometheus.CounterVec
+	matchmakingWait  prometheus.Histogram
+	repoOperations   *prometheus.HistogramVec
+	repoOperationErr *prometheus.CounterVec
+}
+
+var _ port.GameMetrics = (*Metrics)(nil)
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Number of gRPC requests handled, by method and status code.",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of gRPC requests, by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		gamesFinished: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "games_finished_total",
+			Help:      "Number of finished games, by outcome, board configuration and variant.",
+		}, []string{"outcome", "board_size", "winning_length", "variant"}),
+		matchmakingWait: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "matchmaking_wait_seconds",
+			Help:      "Time a game spent pending before a second player joined.",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600, 1800},
+		}),
+		repoOperations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "repository",
+			Name:      "operation_duration_seconds",
+			Help:      "Latency of repository operations, by repository and operation.",
+			Buckets:   []float64{.00001, .00005, .0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+		}, []string{"repository", "op"}),
+		repoOperationErr: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "repository",
+			Name:      "operation_errors_total",
+			Help:      "Number of repository operations that returned an error.",
+		}, []string{"repository", "op"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcRequests,
+		m.rpcDuration,
+		m.gamesFinished,
+		m.matchmakingWait,
+		m.repoOperations,
+		m.repoOperationErr,
+	)
+	return m
+}
+
+// Handler serves the registry in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// RegisterGameRepository exposes game counts per status, read from the
+// repository at scrape time.
+func (m *Metrics) RegisterGameRepository(repo port.GameRepository) {
+	m.registry.MustRegister(&gameCollector{repo: repo})
+}
+
+func (m *Metrics) ObserveRPC(method, code string, duration time.Duration) {
+	m.rpcRequests.WithLabelValues(method, code).Inc()
+	m.rpcDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+}
+
+func (m *Metrics) GameMatched(ctx context.Context, game *entity.Game) {
+	m.matchmakingWait.Observe(game.UpdatedAt.Sub(game.CreatedAt).Seconds())
+}
+
+func (m *Metrics) GameFinished(ctx context.Context, game *entity.Game) {
+	m.gamesFinished.WithLabelValues(
+		game.Status.String(),
+		boardSizeLabel(game),
+		strconv.Itoa(game.WinningLength),
+		game.Variant,
+	).Inc()
+}
+
+// boardSizeLabel is the side of a square board, or width x height for
+// rectangular ones.
+func boardSizeLabel(game *entity.Game) string {
+	if game.IsSquare() {
+		return strconv.Itoa(game.BoardWidth)
+	}
+	return fmt.Sprintf("%dx%d", game.BoardWidth, game.BoardHeight)
+}
+
+func (m *Metrics) observeRepository(repository, op string, start time.Time, err error) {
+	m.repoOperations.WithLabelValues(repository, op).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.repoOperationErr.WithLabelValues(repository, op).Inc()
+	}
+}
+
+var gamesDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "", "games"),
+	"Number of games currently stored, by status.",
+	[]string{"status"}, nil,
+)
+
+type gameCollector struct {
+	repo port.GameRepository
+}
+
+func (c *gameCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- gamesDesc
+}
+
+func (c *gameCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+	for _, status := range []entity.GameStatus{entity.StatusPending, entity.StatusInProgress} {
+		ch <- prometheus.MustNewConstMetric(gamesDesc, prometheus.GaugeValue,
+			float64(c.repo.CountByStatus(ctx, status)), status.String())
+	}
+}
@@ -0,0 +1,88 @@
+// internal/adapters/metrics/metrics_test.go
+package metrics_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"tictactoe/internal/adapters/grpc/interceptor"
+	"tictactoe/internal/adapters/metrics"
+	"tictactoe/internal/adapters/repository"
+	"tictactoe/internal/application/service"
+	"tictactoe/internal/domain/config"
+	"tictactoe/internal/domain/entity"
+)
+
+func scrape(t *testing.T, m *metrics.Metrics) string {
+	t.Helper()
+	server := httptest.NewServer(m.Handler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestMetrics_GameLifecycle(t *testing.T) {
+	m := metrics.New()
+	gameRepo := m.InstrumentGameRepository(repository.NewInMemoryGameRepository())
+	userRepo := m.InstrumentUserRepository(repository.NewInMemoryUserRepository())
+	m.RegisterGameRepository(gameRepo)
+	svc := service.NewGameService(gameRepo, userRepo, config.DefaultConfig(), service.WithMetrics(m))
+	ctx := context.Background()
+
+	_, err := svc.StartGame(ctx, "pending", entity.GameSettings{BoardSize: 4, WinningLength: 3})
+	require.NoError(t, err)
+
+	game, err := svc.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+	_, err = svc.JoinGame(ctx, "player2", game.ID)
+	require.NoError(t, err)
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `tictactoe_games{status="pending"} 1`)
+	assert.Contains(t, body, `tictactoe_games{status="in_progress"} 1`)
+	assert.Contains(t, body, "tictactoe_matchmaking_wait_seconds_count 1")
+	assert.Contains(t, body, `tictactoe_repository_operation_duration_seconds_count{op="save",repository="game"} 3`)
+
+	_, err = svc.GetGame(ctx, "missing", "player1")
+	require.Error(t, err)
+	assert.Contains(t, scrape(t, m), `tictactoe_repository_operation_errors_total{op="find_by_id",repository="game"} 1`)
+
+	svc.MakeMove(ctx, "player1", game.ID, 0, 0)
+	svc.MakeMove(ctx, "player2", game.ID, 1, 0)
+	svc.MakeMove(ctx, "player1", game.ID, 0, 1)
+	svc.MakeMove(ctx, "player2", game.ID, 1, 1)
+	svc.MakeMove(ctx, "player1", game.ID, 0, 2)
+
+	body = scrape(t, m)
+	assert.Contains(t, body, `tictactoe_games_finished_total{board_size="3",outcome="finished_win",variant="standard",winning_length="3"} 1`)
+	assert.Contains(t, body, `tictactoe_games{status="in_progress"} 0`)
+}
+
+func TestMetrics_RPCInterceptor(t *testing.T) {
+	m := metrics.New()
+	unary := interceptor.UnaryMetrics(m)
+	info := &grpc.UnaryServerInfo{FullMethod: "/tictactoe.TicTacToeService/GetGame"}
+
+	_, err := unary(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `tictactoe_grpc_requests_total{code="OK",method="/tictactoe.TicTacToeService/GetGame"} 1`)
+	assert.Contains(t, body, `tictactoe_grpc_request_duration_seconds_count{code="OK",method="/tictactoe.TicTacToeService/GetGame"} 1`)
+}
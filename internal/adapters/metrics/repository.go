@@ -0,0 +1,124 @@
+// internal/adapters/metrics/repository.go
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+// instrumentedGameRepository records the latency and errors of every
+// operation of the wrapped GameRepository.
+type instrumentedGameRepository struct {
+	next    port.GameRepository
+	metrics *Metrics
+}
+
+func (m *Metrics) InstrumentGameRepository(next port.GameRepository) port.GameRepository {
+	return &instrumentedGameRepository{next: next, metrics: m}
+}
+
+func (r *instrumentedGameRepository) Save(ctx context.Context, game *entity.Game) error {
+	start := time.Now()
+	err := r.next.Save(ctx, game)
+	r.metrics.observeRepository("game", "save", start, err)
+	return err
+}
+
+func (r *instrumentedGameRepository) FindByID(ctx context.Context, id string) (*entity.Game, error) {
+	start := time.Now()
+	game, err := r.next.FindByID(ctx, id)
+	r.metrics.observeRepository("game", "find_by_id", start, err)
+	return game, err
+}
+
+func (r *instrumentedGameRepository) FindByJoinCode(ctx context.Context, code string) (*entity.Game, error) {
+	start := time.Now()
+	game, err := r.next.FindByJoinCode(ctx, code)
+	r.metrics.observeRepository("game", "find_by_join_code", start, err)
+	return game, err
+}
+
+func (r *instrumentedGameRepository) FindPendingGames(ctx context.Context, filter port.PendingGameFilter) ([]*entity.Game, int, error) {
+	start := time.Now()
+	games, total, err := r.next.FindPendingGames(ctx, filter)
+	r.metrics.observeRepository("game", "find_pending_games", start, err)
+	return games, total, err
+}
+
+func (r *instrumentedGameRepository) FindGamesByPlayer(ctx context.Context, playerID string) ([]*entity.Game, error) {
+	start := time.Now()
+	games, err := r.next.FindGamesByPlayer(ctx, playerID)
+	r.metrics.observeRepository("game", "find_games_by_player", start, err)
+	return games, err
+}
+
+func (r *instrumentedGameRepository) FindGames(ctx context.Context, filter port.GameFilter) ([]*entity.Game, error) {
+	start := time.Now()
+	games, err := r.next.FindGames(ctx, filter)
+	r.metrics.observeRepository("game", "find_games", start, err)
+	return games, err
+}
+
+func (r *instrumentedGameRepository) Delete(ctx context.Context, id string) error {
+	start := time.Now()
+	err := r.next.Delete(ctx, id)
+	r.metrics.observeRepository("game", "delete", start, err)
+	return err
+}
+
+func (r *instrumentedGameRepository) Count(ctx context.Context) int64 {
+	return r.next.Count(ctx)
+}
+
+func (r *instrumentedGameRepository) CountByStatus(ctx context.Context, status entity.GameStatus) int64 {
+	return r.next.CountByStatus(ctx, status)
+}
+
+func (r *instrumentedGameRepository) Ping(ctx context.Context) error {
+	start := time.Now()
+	err := r.next.Ping(ctx)
+	r.metrics.observeRepository("game", "ping", start, err)
+	return err
+}
+
+// instrumentedUserRepository records the latency and errors of every
+// operation of the wrapped UserRepository.
+type instrumentedUserRepository struct {
+	next    port.UserRepository
+	metrics *Metrics
+}
+
+func (m *Metrics) InstrumentUserRepository(next port.UserRepository) port.UserRepository {
+	return &instrumentedUserRepository{next: next, metrics: m}
+}
+
+func (r *instrumentedUserRepository) SaveStats(ctx context.Context, stats *entity.UserStats) error {
+	start := time.Now()
+	err := r.next.SaveStats(ctx, stats)
+	r.metrics.observeRepository("user", "save_stats", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) FindStatsByUserID(ctx context.Context, userID string) (*entity.UserStats, error) {
+	start := time.Now()
+	stats, err := r.next.FindStatsByUserID(ctx, userID)
+	r.metrics.observeRepository("user", "find_stats_by_user_id", start, err)
+	return stats, err
+}
+
+func (r *instrumentedUserRepository) CreateUserIfNotExists(ctx context.Context, userID string) error {
+	start := time.Now()
+	err := r.next.CreateUserIfNotExists(ctx, userID)
+	r.metrics.observeRepository("user", "create_user_if_not_exists", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) Ping(ctx context.Context) error {
+	start := time.Now()
+	err := r.next.Ping(ctx)
+	r.metrics.observeRepository("user", "ping", start, err)
+	return err
+}
@@ -0,0 +1,160 @@
+// internal/adapters/pubsub/broker.go
+package pubsub
+
+import (
+	"context"
+	"sync"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+// Broker fans game updates out to in-process subscribers. Each subscription
+// only ever holds the latest snapshot of its game, so a slow consumer skips
+// intermediate states instead of blocking publishers or growing a queue.
+// It also counts the spectators behind those subscriptions.
+type Broker struct {
+	mu   sync.Mutex
+	subs map[string]map[*Subscription]struct{}
+	// spectators counts the open spectator streams per game and user.
+	spectators map[string]map[string]int
+}
+
+var (
+	_ port.GameEventPublisher = (*Broker)(nil)
+	_ port.SpectatorTracker   = (*Broker)(nil)
+)
+
+func NewBroker() *Broker {
+	return &Broker{
+		subs:       make(map[string]map[*Subscription]struct{}),
+		spectators: make(map[string]map[string]int),
+	}
+}
+
+type Subscription struct {
+	// C receives a copy of the game after every change.
+	C <-chan *entity.Game
+
+	ch     chan *entity.Game
+	broker *Broker
+	gameID string
+	once   sync.Once
+	// version is the Version of the latest snapshot sent to ch, guarded by
+	// the broker's lock.
+	version int
+}
+
+// Subscribe registers for updates of the given game until Close is called.
+func (b *Broker) Subscribe(gameID string) *Subscription {
+	ch := make(chan *entity.Game, 1)
+	sub := &Subscription{C: ch, ch: ch, broker: b, gameID: gameID}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subs[gameID] == nil {
+		b.subs[gameID] = make(map[*Subscription]struct{})
+	}
+	b.subs[gameID][sub] = struct{}{}
+	return sub
+}
+
+// Subscribers returns the number of open subscriptions for a game.
+func (b *Broker) Subscribers(gameID string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subs[gameID])
+}
+
+// ActiveStreams returns the number of open subscriptions across all games.
+func (b *Broker) ActiveStreams() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var n int
+	for _, subs := range b.subs {
+		n += len(subs)
+	}
+	return n
+}
+
+func (b *Broker) AddSpectator(gameID, userID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.spectators[gameID] == nil {
+		b.spectators[gameID] = make(map[string]int)
+	}
+	b.spectators[gameID][userID]++
+}
+
+func (b *Broker) RemoveSpectator(gameID, userID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	watchers := b.spectators[gameID]
+	if watchers[userID] > 1 {
+		watchers[userID]--
+		return
+	}
+	delete(watchers, userID)
+	if len(watchers) == 0 {
+		delete(b.spectators, gameID)
+	}
+}
+
+func (b *Broker) ClearSpectators(gameID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.spectators, gameID)
+}
+
+func (b *Broker) SpectatorCount(gameID string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.spectators[gameID])
+}
+
+func (b *Broker) PublishGameUpdated(ctx context.Context, game *entity.Game) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs[game.ID] {
+		// Updates saved concurrently can be published in either order;
+		// never replace a snapshot with an older one
+		if game.Version <= sub.version {
+			continue
+		}
+		sub.version = game.Version
+
+		snapshot := game.Clone()
+		select {
+		case sub.ch <- snapshot:
+		default:
+			// Replace the pending snapshot the consumer has not read yet
+			select {
+			case <-sub.ch:
+			default:
+			}
+			sub.ch <- snapshot
+		}
+	}
+}
+
+// Close unregisters the subscription and closes C.
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		s.broker.mu.Lock()
+		defer s.broker.mu.Unlock()
+
+		delete(s.broker.subs[s.gameID], s)
+		if len(s.broker.subs[s.gameID]) == 0 {
+			delete(s.broker.subs, s.gameID)
+		}
+		close(s.ch)
+	})
+}
@@ -0,0 +1,108 @@
+// internal/adapters/pubsub/broker_test.go
+package pubsub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tictactoe/internal/domain/entity"
+)
+
+func TestBroker_DeliversToGameSubscribers(t *testing.T) {
+	broker := NewBroker()
+	game := entity.NewGame("player1", 3, 3)
+	game.Version = 1
+	other := entity.NewGame("player3", 3, 3)
+	other.Version = 1
+
+	sub := broker.Subscribe(game.ID)
+	defer sub.Close()
+
+	broker.PublishGameUpdated(context.Background(), other)
+	broker.PublishGameUpdated(context.Background(), game)
+
+	received := <-sub.C
+	assert.Equal(t, game.ID, received.ID)
+	assert.NotSame(t, game, received)
+}
+
+func TestBroker_SlowConsumerGetsLatestSnapshot(t *testing.T) {
+	broker := NewBroker()
+	game := entity.NewGame("player1", 3, 3)
+	require.NoError(t, game.JoinPlayer("player2"))
+
+	sub := broker.Subscribe(game.ID)
+	defer sub.Close()
+
+	// Publishing never blocks, even though nobody reads in between
+	game.Version = 1
+	broker.PublishGameUpdated(context.Background(), game)
+	require.NoError(t, game.MakeMove("player1", entity.Position{Row: 0, Col: 0}))
+	game.Version = 2
+	broker.PublishGameUpdated(context.Background(), game)
+
+	latest := <-sub.C
+	assert.Equal(t, "X", latest.Board[0][0])
+	assert.Empty(t, sub.C)
+}
+
+func TestBroker_DropsOlderSnapshots(t *testing.T) {
+	broker := NewBroker()
+	older := entity.NewGame("player1", 3, 3)
+	older.Version = 1
+	newer := older.Clone()
+	require.NoError(t, newer.JoinPlayer("player2"))
+	newer.Version = 2
+
+	sub := broker.Subscribe(older.ID)
+	defer sub.Close()
+
+	// Two saves published in reverse order
+	broker.PublishGameUpdated(context.Background(), newer)
+	broker.PublishGameUpdated(context.Background(), older)
+	latest := <-sub.C
+	assert.Equal(t, 2, latest.Version)
+	assert.Empty(t, sub.C)
+
+	// Also once the newer snapshot has been read
+	broker.PublishGameUpdated(context.Background(), older)
+	assert.Empty(t, sub.C)
+}
+
+func TestBroker_Close(t *testing.T) {
+	broker := NewBroker()
+	sub := broker.Subscribe("game")
+	assert.Equal(t, 1, broker.Subscribers("game"))
+	assert.Equal(t, 1, broker.ActiveStreams())
+
+	sub.Close()
+	sub.Close()
+	assert.Equal(t, 0, broker.Subscribers("game"))
+	assert.Equal(t, 0, broker.ActiveStreams())
+
+	_, open := <-sub.C
+	assert.False(t, open)
+}
+
+func TestBroker_Spectators(t *testing.T) {
+	broker := NewBroker()
+	broker.AddSpectator("game", "viewer")
+	broker.AddSpectator("game", "viewer")
+	broker.AddSpectator("game", "other")
+	broker.AddSpectator("another game", "viewer")
+	assert.Equal(t, 2, broker.SpectatorCount("game"))
+
+	// A user counts until their last stream closes
+	broker.RemoveSpectator("game", "viewer")
+	assert.Equal(t, 2, broker.SpectatorCount("game"))
+	broker.RemoveSpectator("game", "viewer")
+	broker.RemoveSpectator("game", "viewer")
+	assert.Equal(t, 1, broker.SpectatorCount("game"))
+
+	broker.ClearSpectators("game")
+	assert.Zero(t, broker.SpectatorCount("game"))
+	assert.Equal(t, 1, broker.SpectatorCount("another game"))
+}
@@ -2,7 +2,11 @@
 package repository
 
 import (
+	"context"
+	"slices"
+	"sort"
 	"sync"
+
 	"tictactoe/internal/domain/entity"
 	"tictactoe/internal/domain/port"
 )
@@ -10,32 +14,76 @@ import (
 type inMemoryGameRepository struct {
 	mu    sync.RWMutex
 	games map[string]*entity.Game
+	// byPlayer indexes game IDs by the players taking part in them.
+	byPlayer map[string]map[string]struct{}
+	// pending indexes public pending games by board configuration.
+	pending pendingIndex
+	// byJoinCode maps the join codes of private games to their IDs.
+	byJoinCode map[string]string
 }
 
 func NewInMemoryGameRepository() port.GameRepository {
 	return &inMemoryGameRepository{
-		games: make(map[string]*entity.Game),
+		games:      make(map[string]*entity.Game),
+		byPlayer:   make(map[string]map[string]struct{}),
+		pending:    make(pendingIndex),
+		byJoinCode: make(map[string]string),
 	}
 }
 
-func (r *inMemoryGameRepository) Save(game *entity.Game) error {
+func (r *inMemoryGameRepository) Save(ctx context.Context, game *entity.Game) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	// Deep copy to prevent external mutations
-	gameCopy := *game
-	boardCopy := make([][]string, len(game.Board))
-	for i, row := range game.Board {
-		boardCopy[i] = make([]string, len(row))
-		copy(boardCopy[i], row)
+	old, exists := r.games[game.ID]
+	if exists && old.Version != game.Version {
+		return port.ErrConcurrentUpdate
 	}
-	gameCopy.Board = boardCopy
+	game.Version++
+
+	// Deep copy to prevent external mutations
+	gameCopy := game.Clone()
 
-	r.games[game.ID] = &gameCopy
+	if exists {
+		r.pending.remove(old)
+	}
+	r.games[game.ID] = gameCopy
+	for _, playerID := range game.Players {
+		r.indexPlayer(playerID, game.ID)
+	}
+	r.pending.add(gameCopy)
+	if game.JoinCode != "" {
+		r.byJoinCode[game.JoinCode] = game.ID
+	}
 	return nil
 }
 
-func (r *inMemoryGameRepository) FindByID(id string) (*entity.Game, error) {
+func (r *inMemoryGameRepository) indexPlayer(playerID, gameID string) {
+	if playerID == "" {
+		return
+	}
+	if r.byPlayer[playerID] == nil {
+		r.byPlayer[playerID] = make(map[string]struct{})
+	}
+	r.byPlayer[playerID][gameID] = struct{}{}
+}
+
+func (r *inMemoryGameRepository) unindexPlayer(playerID, gameID string) {
+	delete(r.byPlayer[playerID], gameID)
+	if len(r.byPlayer[playerID]) == 0 {
+		delete(r.byPlayer, playerID)
+	}
+}
+
+func (r *inMemoryGameRepository) FindByID(ctx context.Context, id string) (*entity.Game, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -45,62 +93,206 @@ func (r *inMemoryGameRepository) FindByID(id string) (*entity.Game, error) {
 	}
 
 	// Deep copy to prevent external mutations
-	gameCopy := *game
-	boardCopy := make([][]string, len(game.Board))
-	for i, row := range game.Board {
-		boardCopy[i] = make([]string, len(row))
-		copy(boardCopy[i], row)
+	return game.Clone(), nil
+}
+
+func (r *inMemoryGameRepository) FindByJoinCode(ctx context.Context, code string) (*entity.Game, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, exists := r.byJoinCode[code]
+	if !exists {
+		return nil, entity.ErrGameNotFound
+	}
+	return r.games[id].Clone(), nil
+}
+
+func (r *inMemoryGameRepository) FindPendingGames(ctx context.Context, filter port.PendingGameFilter) ([]*entity.Game, int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if filter.CreatorID != "" && filter.CreatorID == filter.ExcludeCreatorID {
+		return nil, 0, nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var ranges [][]pendingEntry
+	if filter.CreatorID != "" {
+		// A creator has few games, so their own are read by player instead
+		if entries := r.creatorPendingEntries(filter.CreatorID, filter); len(entries) > 0 {
+			ranges = [][]pendingEntry{entries}
+		}
+	} else {
+		ranges = r.pending.ranges(filter)
+	}
+
+	var excluded map[string]bool
+	if filter.ExcludeCreatorID != "" && filter.CreatorID == "" {
+		entries := r.creatorPendingEntries(filter.ExcludeCreatorID, filter)
+		excluded = make(map[string]bool, len(entries))
+		for _, entry := range entries {
+			excluded[entry.id] = true
+		}
+	}
+
+	total := -len(excluded)
+	for _, entries := range ranges {
+		total += len(entries)
+	}
+
+	page := pageEntries(ranges, excluded, filter.NewestFirst, filter.Offset, filter.Limit)
+	games := make([]*entity.Game, len(page))
+	for i, entry := range page {
+		games[i] = r.games[entry.id].Clone()
 	}
-	gameCopy.Board = boardCopy
+	return games, total, nil
+}
 
-	return &gameCopy, nil
+// creatorPendingEntries returns the public pending games created by userID
+// that match filter, in creation order.
+func (r *inMemoryGameRepository) creatorPendingEntries(userID string, filter port.PendingGameFilter) []pendingEntry {
+	var entries []pendingEntry
+	for id := range r.byPlayer[userID] {
+		game := r.games[id]
+		switch {
+		case game.Status != entity.StatusPending || game.Private || game.Player1ID != userID:
+			continue
+		case !pendingKeyOf(game).matches(filter):
+			continue
+		case !filter.CreatedAfter.IsZero() && !game.CreatedAt.After(filter.CreatedAfter):
+			continue
+		}
+		entries = append(entries, pendingEntryOf(game))
+	}
+	slices.SortFunc(entries, pendingEntry.compare)
+	return entries
 }
 
-func (r *inMemoryGameRepository) FindPendingGames(boardSize, winningLength int) ([]*entity.Game, error) {
+func (r *inMemoryGameRepository) FindGamesByPlayer(ctx context.Context, playerID string) ([]*entity.Game, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	var pendingGames []*entity.Game
+	games := make([]*entity.Game, 0, len(r.byPlayer[playerID]))
+	for id := range r.byPlayer[playerID] {
+		games = append(games, r.games[id].Clone())
+	}
+	return games, nil
+}
+
+func (r *inMemoryGameRepository) FindGames(ctx context.Context, filter port.GameFilter) ([]*entity.Game, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
+	var games []*entity.Game
 	for _, game := range r.games {
-		if game.Status == entity.StatusPending {
-			// Filter by parameters if specified
-			if boardSize > 0 && game.BoardSize != boardSize {
-				continue
-			}
-			if winningLength > 0 && game.WinningLength != winningLength {
-				continue
-			}
-
-			// Deep copy
-			gameCopy := *game
-			boardCopy := make([][]string, len(game.Board))
-			for i, row := range game.Board {
-				boardCopy[i] = make([]string, len(row))
-				copy(boardCopy[i], row)
-			}
-			gameCopy.Board = boardCopy
-
-			pendingGames = append(pendingGames, &gameCopy)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if matchesFilter(game, filter) {
+			games = append(games, game.Clone())
 		}
 	}
 
-	return pendingGames, nil
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].CreatedAt.After(games[j].CreatedAt)
+	})
+	if filter.Limit > 0 && len(games) > filter.Limit {
+		games = games[:filter.Limit]
+	}
+	return games, nil
+}
+
+func matchesFilter(game *entity.Game, filter port.GameFilter) bool {
+	switch {
+	case filter.Status != nil && game.Status != *filter.Status:
+		return false
+	case filter.PlayerID != "" && !game.IsPlayerInGame(filter.PlayerID):
+		return false
+	case filter.BoardSize > 0 && (game.BoardWidth != filter.BoardSize || game.BoardHeight != filter.BoardSize):
+		return false
+	case filter.BoardWidth > 0 && game.BoardWidth != filter.BoardWidth:
+		return false
+	case filter.BoardHeight > 0 && game.BoardHeight != filter.BoardHeight:
+		return false
+	case filter.WinningLength > 0 && game.WinningLength != filter.WinningLength:
+		return false
+	case !filter.CreatedAfter.IsZero() && !game.CreatedAt.After(filter.CreatedAfter):
+		return false
+	case !filter.CreatedBefore.IsZero() && !game.CreatedAt.Before(filter.CreatedBefore):
+		return false
+	}
+	return true
 }
 
-// unused method to clean up the stale games
-func (r *inMemoryGameRepository) Delete(id string) error {
+// Delete removes the game and drops it from every index. Deleting a game
+// that does not exist is not an error.
+func (r *inMemoryGameRepository) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if game, exists := r.games[id]; exists {
+		for _, playerID := range game.Players {
+			r.unindexPlayer(playerID, id)
+		}
+		r.pending.remove(game)
+		delete(r.byJoinCode, game.JoinCode)
+	}
 	delete(r.games, id)
 	return nil
 }
 
-// Count returns the number of games in the repository. unused method to get the number of games
-func (r *inMemoryGameRepository) Count() int64 {
+// Count returns the number of games in the repository, whatever their
+// status, or 0 if ctx is done.
+func (r *inMemoryGameRepository) Count(ctx context.Context) int64 {
+	if ctx.Err() != nil {
+		return 0
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	return int64(len(r.games))
 }
+
+// CountByStatus returns the number of games currently in the given status,
+// or 0 if ctx is done.
+func (r *inMemoryGameRepository) CountByStatus(ctx context.Context, status entity.GameStatus) int64 {
+	if ctx.Err() != nil {
+		return 0
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, game := range r.games {
+		if game.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// Ping always succeeds unless the context is done; the map is always available.
+func (r *inMemoryGameRepository) Ping(ctx context.Context) error {
+	return ctx.Err()
+}
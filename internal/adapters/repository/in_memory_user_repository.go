@@ -2,6 +2,7 @@
 package repository
 
 import (
+	"context"
 	"sync"
 	"tictactoe/internal/domain/entity"
 	"tictactoe/internal/domain/port"
@@ -18,17 +19,23 @@ func NewInMemoryUserRepository() port.UserRepository {
 	}
 }
 
-func (r *inMemoryUserRepository) SaveStats(stats *entity.UserStats) error {
+func (r *inMemoryUserRepository) SaveStats(ctx context.Context, stats *entity.UserStats) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	
-	// Deep copy
-	statsCopy := *stats
-	r.users[stats.UserID] = &statsCopy
+	r.users[stats.UserID] = stats.Clone()
 	return nil
 }
 
-func (r *inMemoryUserRepository) FindStatsByUserID(userID string) (*entity.UserStats, error) {
+func (r *inMemoryUserRepository) FindStatsByUserID(ctx context.Context, userID string) (*entity.UserStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	
@@ -37,12 +44,14 @@ func (r *inMemoryUserRepository) FindStatsByUserID(userID string) (*entity.UserS
 		return nil, entity.ErrUserNotFound
 	}
 	
-	// Deep copy
-	statsCopy := *stats
-	return &statsCopy, nil
+	return stats.Clone(), nil
 }
 
-func (r *inMemoryUserRepository) CreateUserIfNotExists(userID string) error {
+func (r *inMemoryUserRepository) CreateUserIfNotExists(ctx context.Context, userID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	
@@ -52,3 +61,8 @@ func (r *inMemoryUserRepository) CreateUserIfNotExists(userID string) error {
 	
 	return nil
 }
+
+// Ping always succeeds unless the context is done; the map is always available.
+func (r *inMemoryUserRepository) Ping(ctx context.Context) error {
+	return ctx.Err()
+}
@@ -0,0 +1,114 @@
+// internal/adapters/repository/logging_game_repository.go
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+// loggingGameRepository decorates a GameRepository with debug-level logs of
+// every operation, its duration and its error, if any.
+type loggingGameRepository struct {
+	next   port.GameRepository
+	logger *slog.Logger
+}
+
+func NewLoggingGameRepository(next port.GameRepository, logger *slog.Logger) port.GameRepository {
+	return &loggingGameRepository{
+		next:   next,
+		logger: logger.With(slog.String("repository", "game")),
+	}
+}
+
+func (r *loggingGameRepository) Save(ctx context.Context, game *entity.Game) error {
+	start := time.Now()
+	err := r.next.Save(ctx, game)
+	logOperation(ctx, r.logger, "save", start, err, slog.String("game_id", game.ID))
+	return err
+}
+
+func (r *loggingGameRepository) FindByID(ctx context.Context, id string) (*entity.Game, error) {
+	start := time.Now()
+	game, err := r.next.FindByID(ctx, id)
+	logOperation(ctx, r.logger, "find_by_id", start, err, slog.String("game_id", id))
+	return game, err
+}
+
+func (r *loggingGameRepository) FindByJoinCode(ctx context.Context, code string) (*entity.Game, error) {
+	start := time.Now()
+	game, err := r.next.FindByJoinCode(ctx, code)
+	logOperation(ctx, r.logger, "find_by_join_code", start, err)
+	return game, err
+}
+
+func (r *loggingGameRepository) FindPendingGames(ctx context.Context, filter port.PendingGameFilter) ([]*entity.Game, int, error) {
+	start := time.Now()
+	games, total, err := r.next.FindPendingGames(ctx, filter)
+	logOperation(ctx, r.logger, "find_pending_games", start, err,
+		slog.Int("board_size", filter.BoardSize),
+		slog.Int("board_width", filter.BoardWidth),
+		slog.Int("board_height", filter.BoardHeight),
+		slog.Int("winning_length", filter.WinningLength),
+		slog.String("variant", filter.Variant),
+		slog.Int("seats", filter.Seats),
+		slog.Bool("exclude_custom_setup", filter.ExcludeCustomSetup),
+		slog.Int("offset", filter.Offset),
+		slog.Int("limit", filter.Limit),
+		slog.Int("results", len(games)),
+		slog.Int("total", total))
+	return games, total, err
+}
+
+func (r *loggingGameRepository) FindGamesByPlayer(ctx context.Context, playerID string) ([]*entity.Game, error) {
+	start := time.Now()
+	games, err := r.next.FindGamesByPlayer(ctx, playerID)
+	logOperation(ctx, r.logger, "find_games_by_player", start, err,
+		slog.String("player_id", playerID),
+		slog.Int("results", len(games)))
+	return games, err
+}
+
+func (r *loggingGameRepository) FindGames(ctx context.Context, filter port.GameFilter) ([]*entity.Game, error) {
+	start := time.Now()
+	games, err := r.next.FindGames(ctx, filter)
+	logOperation(ctx, r.logger, "find_games", start, err,
+		slog.String("player_id", filter.PlayerID),
+		slog.Int("results", len(games)))
+	return games, err
+}
+
+func (r *loggingGameRepository) Delete(ctx context.Context, id string) error {
+	start := time.Now()
+	err := r.next.Delete(ctx, id)
+	logOperation(ctx, r.logger, "delete", start, err, slog.String("game_id", id))
+	return err
+}
+
+func (r *loggingGameRepository) Count(ctx context.Context) int64 {
+	return r.next.Count(ctx)
+}
+
+func (r *loggingGameRepository) CountByStatus(ctx context.Context, status entity.GameStatus) int64 {
+	return r.next.CountByStatus(ctx, status)
+}
+
+func (r *loggingGameRepository) Ping(ctx context.Context) error {
+	start := time.Now()
+	err := r.next.Ping(ctx)
+	logOperation(ctx, r.logger, "ping", start, err)
+	return err
+}
+
+func logOperation(ctx context.Context, logger *slog.Logger, op string, start time.Time, err error, attrs ...slog.Attr) {
+	attrs = append(attrs,
+		slog.String("op", op),
+		slog.Duration("duration", time.Since(start)))
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	logger.LogAttrs(ctx, slog.LevelDebug, "repository operation", attrs...)
+}
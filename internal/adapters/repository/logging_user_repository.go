@@ -0,0 +1,53 @@
+// internal/adapters/repository/logging_user_repository.go
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+// loggingUserRepository decorates a UserRepository with debug-level logs of
+// every operation, its duration and its error, if any.
+type loggingUserRepository struct {
+	next   port.UserRepository
+	logger *slog.Logger
+}
+
+func NewLoggingUserRepository(next port.UserRepository, logger *slog.Logger) port.UserRepository {
+	return &loggingUserRepository{
+		next:   next,
+		logger: logger.With(slog.String("repository", "user")),
+	}
+}
+
+func (r *loggingUserRepository) SaveStats(ctx context.Context, stats *entity.UserStats) error {
+	start := time.Now()
+	err := r.next.SaveStats(ctx, stats)
+	logOperation(ctx, r.logger, "save_stats", start, err, slog.String("user_id", stats.UserID))
+	return err
+}
+
+func (r *loggingUserRepository) FindStatsByUserID(ctx context.Context, userID string) (*entity.UserStats, error) {
+	start := time.Now()
+	stats, err := r.next.FindStatsByUserID(ctx, userID)
+	logOperation(ctx, r.logger, "find_stats_by_user_id", start, err, slog.String("user_id", userID))
+	return stats, err
+}
+
+func (r *loggingUserRepository) CreateUserIfNotExists(ctx context.Context, userID string) error {
+	start := time.Now()
+	err := r.next.CreateUserIfNotExists(ctx, userID)
+	logOperation(ctx, r.logger, "create_user_if_not_exists", start, err, slog.String("user_id", userID))
+	return err
+}
+
+func (r *loggingUserRepository) Ping(ctx context.Context) error {
+	start := time.Now()
+	err := r.next.Ping(ctx)
+	logOperation(ctx, r.logger, "ping", start, err)
+	return err
+}
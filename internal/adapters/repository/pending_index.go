@@ -0,0 +1,221 @@
+// internal/adapters/repository/pending_index.go
+package repository
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+// pendingIndex holds the public pending games by board configuration. Each
+// bucket is kept in creation order, so a page of games is read without
+// looking at the rest of the bucket.
+type pendingIndex map[pendingKey][]pendingEntry
+
+type pendingKey struct {
+	boardWidth    int
+	boardHeight   int
+	winningLength int
+	variant       string
+	seats         int
+	customSetup   bool
+	ranked        bool
+}
+
+func pendingKeyOf(game *entity.Game) pendingKey {
+	return pendingKey{game.BoardWidth, game.BoardHeight, game.WinningLength, game.Variant, game.Seats, game.HasCustomSetup(), game.Ranked}
+}
+
+// matches reports whether games under k can match filter.
+func (k pendingKey) matches(filter port.PendingGameFilter) bool {
+	switch {
+	case filter.BoardSize > 0 && (k.boardWidth != filter.BoardSize || k.boardHeight != filter.BoardSize):
+		return false
+	case filter.BoardWidth > 0 && k.boardWidth != filter.BoardWidth:
+		return false
+	case filter.BoardHeight > 0 && k.boardHeight != filter.BoardHeight:
+		return false
+	case filter.WinningLength > 0 && k.winningLength != filter.WinningLength:
+		return false
+	case filter.Variant != "" && k.variant != filter.Variant:
+		return false
+	case filter.Seats > 0 && k.seats != filter.Seats:
+		return false
+	case filter.ExcludeCustomSetup && k.customSetup:
+		return false
+	case filter.Ranked != nil && k.ranked != *filter.Ranked:
+		return false
+	}
+	return true
+}
+
+// exactPendingKey returns the only key filter can match if it names every
+// part of one, as matchmaking does.
+func exactPendingKey(filter port.PendingGameFilter) (pendingKey, bool) {
+	if filter.Ranked == nil {
+		return pendingKey{}, false
+	}
+	width, height := filter.BoardWidth, filter.BoardHeight
+	if width == 0 {
+		width = filter.BoardSize
+	}
+	if height == 0 {
+		height = filter.BoardSize
+	}
+	key := pendingKey{width, height, filter.WinningLength, filter.Variant, filter.Seats, false, *filter.Ranked}
+	exact := width > 0 && height > 0 && filter.WinningLength > 0 && filter.Variant != "" &&
+		filter.Seats > 0 && filter.ExcludeCustomSetup
+	return key, exact && key.matches(filter)
+}
+
+// pendingEntry is a game in a bucket, ordered by creation time and then ID.
+type pendingEntry struct {
+	createdAt time.Time
+	id        string
+}
+
+func pendingEntryOf(game *entity.Game) pendingEntry {
+	return pendingEntry{createdAt: game.CreatedAt, id: game.ID}
+}
+
+func (e pendingEntry) compare(other pendingEntry) int {
+	if c := e.createdAt.Compare(other.createdAt); c != 0 {
+		return c
+	}
+	return strings.Compare(e.id, other.id)
+}
+
+// add indexes game if it is public and pending.
+func (idx pendingIndex) add(game *entity.Game) {
+	if game.Status != entity.StatusPending || game.Private {
+		return
+	}
+	key := pendingKeyOf(game)
+	entry := pendingEntryOf(game)
+	bucket := idx[key]
+	if i, found := slices.BinarySearchFunc(bucket, entry, pendingEntry.compare); !found {
+		idx[key] = slices.Insert(bucket, i, entry)
+	}
+}
+
+func (idx pendingIndex) remove(game *entity.Game) {
+	key := pendingKeyOf(game)
+	bucket := idx[key]
+	i, found := slices.BinarySearchFunc(bucket, pendingEntryOf(game), pendingEntry.compare)
+	if !found {
+		return
+	}
+	if bucket = slices.Delete(bucket, i, i+1); len(bucket) == 0 {
+		delete(idx, key)
+	} else {
+		idx[key] = bucket
+	}
+}
+
+// ranges returns, for every bucket that can match filter, its entries
+// created after filter.CreatedAfter. The ranges share the index's storage
+// and are only valid while the repository lock is held.
+func (idx pendingIndex) ranges(filter port.PendingGameFilter) [][]pendingEntry {
+	var ranges [][]pendingEntry
+	addRange := func(bucket []pendingEntry) {
+		if bucket = createdAfter(bucket, filter.CreatedAfter); len(bucket) > 0 {
+			ranges = append(ranges, bucket)
+		}
+	}
+
+	if key, ok := exactPendingKey(filter); ok {
+		addRange(idx[key])
+		return ranges
+	}
+	// There are far fewer configurations than games
+	for key, bucket := range idx {
+		if key.matches(filter) {
+			addRange(bucket)
+		}
+	}
+	return ranges
+}
+
+// createdAfter drops the entries of a sorted range created at or before
+// after, if set.
+func createdAfter(entries []pendingEntry, after time.Time) []pendingEntry {
+	if after.IsZero() {
+		return entries
+	}
+	start := sort.Search(len(entries), func(i int) bool {
+		return entries[i].createdAt.After(after)
+	})
+	return entries[start:]
+}
+
+// pageEntries merges sorted ranges in creation order, oldest first unless
+// newestFirst, leaving out excluded IDs, and returns limit entries (all if
+// limit is 0) after skipping offset. A single range without exclusions is
+// sliced directly.
+func pageEntries(ranges [][]pendingEntry, excluded map[string]bool, newestFirst bool, offset, limit int) []pendingEntry {
+	if len(ranges) == 1 && len(excluded) == 0 {
+		entries := ranges[0]
+		if offset >= len(entries) {
+			return nil
+		}
+		end := len(entries)
+		if limit > 0 {
+			end = min(offset+limit, end)
+		}
+		if !newestFirst {
+			return entries[offset:end]
+		}
+		page := slices.Clone(entries[len(entries)-end : len(entries)-offset])
+		slices.Reverse(page)
+		return page
+	}
+
+	// Cursors into each range: the next entry is taken from the front, or
+	// from the back when newest first
+	ranges = slices.Clone(ranges)
+	var page []pendingEntry
+	for limit == 0 || len(page) < limit {
+		next := -1
+		for i, entries := range ranges {
+			if len(entries) == 0 {
+				continue
+			}
+			if next < 0 || isBefore(entries, ranges[next], newestFirst) {
+				next = i
+			}
+		}
+		if next < 0 {
+			break
+		}
+
+		var entry pendingEntry
+		if newestFirst {
+			entry = ranges[next][len(ranges[next])-1]
+			ranges[next] = ranges[next][:len(ranges[next])-1]
+		} else {
+			entry = ranges[next][0]
+			ranges[next] = ranges[next][1:]
+		}
+
+		switch {
+		case excluded[entry.id]:
+		case offset > 0:
+			offset--
+		default:
+			page = append(page, entry)
+		}
+	}
+	return page
+}
+
+// isBefore reports whether the next entry of a comes before that of b.
+func isBefore(a, b []pendingEntry, newestFirst bool) bool {
+	if newestFirst {
+		return a[len(a)-1].compare(b[len(b)-1]) > 0
+	}
+	return a[0].compare(b[0]) < 0
+}
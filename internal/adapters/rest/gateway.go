@@ -0,0 +1,82 @@
+// internal/adapters/rest/gateway.go
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"tictactoe/internal/adapters/grpc/interceptor"
+	pb "tictactoe/proto"
+)
+
+// NewHandler returns an HTTP/JSON gateway that translates REST calls into
+// TicTacToeService RPCs on conn, so that requests go through the same
+// interceptors as native gRPC clients. The OpenAPI document is served at
+// /openapi.json.
+func NewHandler(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	gateway := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithErrorHandler(errorHandler),
+	)
+	if err := pb.RegisterTicTacToeServiceHandler(ctx, gateway, conn); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", gateway)
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(pb.OpenAPIDocument)
+	})
+	return mux, nil
+}
+
+// incomingHeaderMatcher forwards the request ID header in addition to the
+// gateway's default set of permanent HTTP headers.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, interceptor.RequestIDHeader) {
+		return interceptor.RequestIDHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// errorHandler writes the gRPC status as JSON with the HTTP status from
+// HTTPStatusFromCode.
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	body, merr := marshaler.Marshal(st.Proto())
+	if merr != nil {
+		http.Error(w, `{"code": 13, "message": "failed to marshal error"}`, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", marshaler.ContentType(st.Proto()))
+	w.WriteHeader(HTTPStatusFromCode(st.Code()))
+	w.Write(body)
+}
+
+// HTTPStatusFromCode maps gRPC codes to HTTP statuses. It follows the
+// gateway's standard mapping, except that FailedPrecondition (a move or
+// join that conflicts with the game state) is reported as 409 Conflict.
+func HTTPStatusFromCode(code codes.Code) int {
+	if code == codes.FailedPrecondition {
+		return http.StatusConflict
+	}
+	return runtime.HTTPStatusFromCode(code)
+}
@@ -0,0 +1,145 @@
+// internal/adapters/tracing/repository.go
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+// tracingGameRepository starts a client span around every GameRepository
+// operation.
+type tracingGameRepository struct {
+	next   port.GameRepository
+	tracer trace.Tracer
+}
+
+func NewGameRepository(next port.GameRepository, provider trace.TracerProvider) port.GameRepository {
+	return &tracingGameRepository{
+		next:   next,
+		tracer: provider.Tracer(instrumentationName),
+	}
+}
+
+func startRepositorySpan(ctx context.Context, tracer trace.Tracer, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+func (r *tracingGameRepository) Save(ctx context.Context, game *entity.Game) (err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "GameRepository.Save", GameIDKey.String(game.ID))
+	defer func() { endSpan(span, err) }()
+
+	return r.next.Save(ctx, game)
+}
+
+func (r *tracingGameRepository) FindByID(ctx context.Context, id string) (game *entity.Game, err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "GameRepository.FindByID", GameIDKey.String(id))
+	defer func() { endSpan(span, err) }()
+
+	return r.next.FindByID(ctx, id)
+}
+
+func (r *tracingGameRepository) FindByJoinCode(ctx context.Context, code string) (game *entity.Game, err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "GameRepository.FindByJoinCode")
+	defer func() { endSpan(span, err) }()
+
+	return r.next.FindByJoinCode(ctx, code)
+}
+
+func (r *tracingGameRepository) FindPendingGames(ctx context.Context, filter port.PendingGameFilter) (games []*entity.Game, total int, err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "GameRepository.FindPendingGames",
+		attribute.Int("game.board_size", filter.BoardSize),
+		attribute.Int("game.board_width", filter.BoardWidth),
+		attribute.Int("game.board_height", filter.BoardHeight),
+		attribute.Int("game.winning_length", filter.WinningLength),
+		attribute.String("game.variant", filter.Variant),
+		attribute.Int("game.seats", filter.Seats),
+		attribute.Bool("game.exclude_custom_setup", filter.ExcludeCustomSetup),
+		attribute.Int("page.offset", filter.Offset),
+		attribute.Int("page.limit", filter.Limit))
+	defer func() { endSpan(span, err) }()
+
+	return r.next.FindPendingGames(ctx, filter)
+}
+
+func (r *tracingGameRepository) FindGamesByPlayer(ctx context.Context, playerID string) (games []*entity.Game, err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "GameRepository.FindGamesByPlayer", UserIDKey.String(playerID))
+	defer func() { endSpan(span, err) }()
+
+	return r.next.FindGamesByPlayer(ctx, playerID)
+}
+
+func (r *tracingGameRepository) FindGames(ctx context.Context, filter port.GameFilter) (games []*entity.Game, err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "GameRepository.FindGames", UserIDKey.String(filter.PlayerID))
+	defer func() { endSpan(span, err) }()
+
+	return r.next.FindGames(ctx, filter)
+}
+
+func (r *tracingGameRepository) Delete(ctx context.Context, id string) (err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "GameRepository.Delete", GameIDKey.String(id))
+	defer func() { endSpan(span, err) }()
+
+	return r.next.Delete(ctx, id)
+}
+
+func (r *tracingGameRepository) Count(ctx context.Context) int64 {
+	return r.next.Count(ctx)
+}
+
+func (r *tracingGameRepository) CountByStatus(ctx context.Context, status entity.GameStatus) int64 {
+	return r.next.CountByStatus(ctx, status)
+}
+
+func (r *tracingGameRepository) Ping(ctx context.Context) (err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "GameRepository.Ping")
+	defer func() { endSpan(span, err) }()
+
+	return r.next.Ping(ctx)
+}
+
+// tracingUserRepository starts a client span around every UserRepository
+// operation.
+type tracingUserRepository struct {
+	next   port.UserRepository
+	tracer trace.Tracer
+}
+
+func NewUserRepository(next port.UserRepository, provider trace.TracerProvider) port.UserRepository {
+	return &tracingUserRepository{
+		next:   next,
+		tracer: provider.Tracer(instrumentationName),
+	}
+}
+
+func (r *tracingUserRepository) SaveStats(ctx context.Context, stats *entity.UserStats) (err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "UserRepository.SaveStats", UserIDKey.String(stats.UserID))
+	defer func() { endSpan(span, err) }()
+
+	return r.next.SaveStats(ctx, stats)
+}
+
+func (r *tracingUserRepository) FindStatsByUserID(ctx context.Context, userID string) (stats *entity.UserStats, err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "UserRepository.FindStatsByUserID", UserIDKey.String(userID))
+	defer func() { endSpan(span, err) }()
+
+	return r.next.FindStatsByUserID(ctx, userID)
+}
+
+func (r *tracingUserRepository) CreateUserIfNotExists(ctx context.Context, userID string) (err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "UserRepository.CreateUserIfNotExists", UserIDKey.String(userID))
+	defer func() { endSpan(span, err) }()
+
+	return r.next.CreateUserIfNotExists(ctx, userID)
+}
+
+func (r *tracingUserRepository) Ping(ctx context.Context) (err error) {
+	ctx, span := startRepositorySpan(ctx, r.tracer, "UserRepository.Ping")
+	defer func() { endSpan(span, err) }()
+
+	return r.next.Ping(ctx)
+}
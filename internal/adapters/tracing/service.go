@@ -0,0 +1,235 @@
+// internal/adapters/tracing/service.go
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+// tracingGameService starts a span around every GameService call.
+type tracingGameService struct {
+	next   port.GameService
+	tracer trace.Tracer
+}
+
+func NewGameService(next port.GameService, provider trace.TracerProvider) port.GameService {
+	return &tracingGameService{
+		next:   next,
+		tracer: provider.Tracer(instrumentationName),
+	}
+}
+
+func (s *tracingGameService) start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, "GameService."+name, trace.WithAttributes(attrs...))
+}
+
+func (s *tracingGameService) StartGame(ctx context.Context, userID string, settings entity.GameSettings) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "StartGame", append(settingsAttributes(settings), UserIDKey.String(userID))...)
+	defer func() { endSpan(span, err) }()
+
+	game, err = s.next.StartGame(ctx, userID, settings)
+	if err == nil {
+		span.SetAttributes(GameIDKey.String(game.ID), attribute.String("game.status", game.Status.String()))
+	}
+	return game, err
+}
+
+func (s *tracingGameService) StartPrivateGame(ctx context.Context, userID string, settings entity.GameSettings, invitedUserID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "StartPrivateGame", append(settingsAttributes(settings), UserIDKey.String(userID))...)
+	defer func() { endSpan(span, err) }()
+
+	game, err = s.next.StartPrivateGame(ctx, userID, settings, invitedUserID)
+	if err == nil {
+		span.SetAttributes(GameIDKey.String(game.ID))
+	}
+	return game, err
+}
+
+func settingsAttributes(settings entity.GameSettings) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("game.board_size", settings.BoardSize),
+		attribute.Int("game.board_width", settings.BoardWidth),
+		attribute.Int("game.board_height", settings.BoardHeight),
+		attribute.Int("game.winning_length", settings.WinningLength),
+		attribute.String("game.variant", settings.Variant),
+		attribute.Int("game.seats", settings.Seats),
+		attribute.Int("game.obstacles", len(settings.Obstacles)),
+		attribute.Int("game.random_obstacles", settings.RandomObstacles),
+		attribute.Int("game.handicap_stones", len(settings.HandicapStones)),
+	}
+}
+
+func (s *tracingGameService) SearchPendingGames(ctx context.Context, query port.PendingGameQuery) (page *port.GamePage, err error) {
+	ctx, span := s.start(ctx, "SearchPendingGames",
+		attribute.Int("game.board_size", query.Filter.BoardSize),
+		attribute.Int("game.board_width", query.Filter.BoardWidth),
+		attribute.Int("game.board_height", query.Filter.BoardHeight),
+		attribute.Int("game.winning_length", query.Filter.WinningLength),
+		attribute.Int("game.seats", query.Filter.Seats),
+		attribute.Int("page.size", query.PageSize))
+	defer func() { endSpan(span, err) }()
+
+	page, err = s.next.SearchPendingGames(ctx, query)
+	if err == nil {
+		span.SetAttributes(attribute.Int("games.count", len(page.Games)), attribute.Int("games.total", page.TotalSize))
+	}
+	return page, err
+}
+
+func (s *tracingGameService) JoinGame(ctx context.Context, userID, gameID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "JoinGame", UserIDKey.String(userID), GameIDKey.String(gameID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.JoinGame(ctx, userID, gameID)
+}
+
+func (s *tracingGameService) JoinGameByCode(ctx context.Context, userID, joinCode string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "JoinGameByCode", UserIDKey.String(userID))
+	defer func() { endSpan(span, err) }()
+
+	game, err = s.next.JoinGameByCode(ctx, userID, joinCode)
+	if err == nil {
+		span.SetAttributes(GameIDKey.String(game.ID))
+	}
+	return game, err
+}
+
+func (s *tracingGameService) MakeMove(ctx context.Context, userID, gameID string, row, col int) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "MakeMove",
+		UserIDKey.String(userID),
+		GameIDKey.String(gameID),
+		attribute.Int("move.row", row),
+		attribute.Int("move.col", col))
+	defer func() { endSpan(span, err) }()
+
+	game, err = s.next.MakeMove(ctx, userID, gameID, row, col)
+	if err == nil {
+		span.SetAttributes(attribute.String("game.status", game.Status.String()))
+	}
+	return game, err
+}
+
+func (s *tracingGameService) GetGame(ctx context.Context, gameID, userID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "GetGame", GameIDKey.String(gameID), UserIDKey.String(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.GetGame(ctx, gameID, userID)
+}
+
+func (s *tracingGameService) GetUserStats(ctx context.Context, userID string) (stats *entity.UserStats, err error) {
+	ctx, span := s.start(ctx, "GetUserStats", UserIDKey.String(userID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.GetUserStats(ctx, userID)
+}
+
+func (s *tracingGameService) SpectateGame(ctx context.Context, gameID, userID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "SpectateGame", UserIDKey.String(userID), GameIDKey.String(gameID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.SpectateGame(ctx, gameID, userID)
+}
+
+func (s *tracingGameService) StopSpectating(ctx context.Context, gameID, userID string) (err error) {
+	ctx, span := s.start(ctx, "StopSpectating", UserIDKey.String(userID), GameIDKey.String(gameID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.StopSpectating(ctx, gameID, userID)
+}
+
+func (s *tracingGameService) SetSpectatorsAllowed(ctx context.Context, userID, gameID string, allowed bool) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "SetSpectatorsAllowed",
+		UserIDKey.String(userID),
+		GameIDKey.String(gameID),
+		attribute.Bool("game.spectators_allowed", allowed))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.SetSpectatorsAllowed(ctx, userID, gameID, allowed)
+}
+
+func (s *tracingGameService) ListLiveGames(ctx context.Context, pageToken string, pageSize int) (page *port.GamePage, err error) {
+	ctx, span := s.start(ctx, "ListLiveGames", attribute.Int("page.size", pageSize))
+	defer func() { endSpan(span, err) }()
+
+	page, err = s.next.ListLiveGames(ctx, pageToken, pageSize)
+	if err == nil {
+		span.SetAttributes(attribute.Int("games.count", len(page.Games)))
+	}
+	return page, err
+}
+
+func (s *tracingGameService) OfferRematch(ctx context.Context, userID, gameID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "OfferRematch", UserIDKey.String(userID), GameIDKey.String(gameID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.OfferRematch(ctx, userID, gameID)
+}
+
+func (s *tracingGameService) AcceptRematch(ctx context.Context, userID, gameID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "AcceptRematch", UserIDKey.String(userID), GameIDKey.String(gameID))
+	defer func() { endSpan(span, err) }()
+
+	game, err = s.next.AcceptRematch(ctx, userID, gameID)
+	if err == nil {
+		span.SetAttributes(attribute.String("game.rematch_id", game.ID))
+	}
+	return game, err
+}
+
+func (s *tracingGameService) DeclineRematch(ctx context.Context, userID, gameID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "DeclineRematch", UserIDKey.String(userID), GameIDKey.String(gameID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.DeclineRematch(ctx, userID, gameID)
+}
+
+func (s *tracingGameService) OfferDraw(ctx context.Context, userID, gameID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "OfferDraw", UserIDKey.String(userID), GameIDKey.String(gameID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.OfferDraw(ctx, userID, gameID)
+}
+
+func (s *tracingGameService) AcceptDraw(ctx context.Context, userID, gameID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "AcceptDraw", UserIDKey.String(userID), GameIDKey.String(gameID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.AcceptDraw(ctx, userID, gameID)
+}
+
+func (s *tracingGameService) DeclineDraw(ctx context.Context, userID, gameID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "DeclineDraw", UserIDKey.String(userID), GameIDKey.String(gameID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.DeclineDraw(ctx, userID, gameID)
+}
+
+func (s *tracingGameService) RequestTakeback(ctx context.Context, userID, gameID string) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "RequestTakeback", UserIDKey.String(userID), GameIDKey.String(gameID))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.RequestTakeback(ctx, userID, gameID)
+}
+
+func (s *tracingGameService) RespondTakeback(ctx context.Context, userID, gameID string, accept bool) (game *entity.Game, err error) {
+	ctx, span := s.start(ctx, "RespondTakeback", UserIDKey.String(userID), GameIDKey.String(gameID), attribute.Bool("takeback.accept", accept))
+	defer func() { endSpan(span, err) }()
+
+	return s.next.RespondTakeback(ctx, userID, gameID, accept)
+}
+
+func (s *tracingGameService) ListUserGames(ctx context.Context, userID string, statuses []entity.GameStatus, pageToken string, pageSize int) (page *port.GamePage, err error) {
+	ctx, span := s.start(ctx, "ListUserGames", UserIDKey.String(userID), attribute.Int("page.size", pageSize))
+	defer func() { endSpan(span, err) }()
+
+	page, err = s.next.ListUserGames(ctx, userID, statuses, pageToken, pageSize)
+	if err == nil {
+		span.SetAttributes(attribute.Int("games.count", len(page.Games)))
+	}
+	return page, err
+}
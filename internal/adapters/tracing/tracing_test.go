@@ -0,0 +1,124 @@
+// internal/adapters/tracing/tracing_test.go
+package tracing_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"tictactoe/internal/adapters/grpc/handler"
+	"tictactoe/internal/adapters/repository"
+	"tictactoe/internal/adapters/tracing"
+	"tictactoe/internal/application/service"
+	"tictactoe/internal/domain/config"
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+	pb "tictactoe/proto"
+)
+
+func newTracedService(provider *sdktrace.TracerProvider) port.GameService {
+	gameRepo := tracing.NewGameRepository(repository.NewInMemoryGameRepository(), provider)
+	userRepo := tracing.NewUserRepository(repository.NewInMemoryUserRepository(), provider)
+	return tracing.NewGameService(service.NewGameService(gameRepo, userRepo, config.DefaultConfig()), provider)
+}
+
+func spanByName(spans []sdktrace.ReadOnlySpan, name string) sdktrace.ReadOnlySpan {
+	for _, span := range spans {
+		if span.Name() == name {
+			return span
+		}
+	}
+	return nil
+}
+
+func attributeValue(span sdktrace.ReadOnlySpan, key attribute.Key) string {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestTracing_ServiceAndRepositorySpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	svc := newTracedService(provider)
+	ctx := context.Background()
+
+	game, err := svc.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+
+	_, err = svc.GetGame(ctx, game.ID, "intruder")
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+
+	start := spanByName(spans, "GameService.StartGame")
+	require.NotNil(t, start)
+	assert.Equal(t, "player1", attributeValue(start, tracing.UserIDKey))
+	assert.Equal(t, game.ID, attributeValue(start, tracing.GameIDKey))
+
+	save := spanByName(spans, "GameRepository.Save")
+	require.NotNil(t, save)
+	assert.Equal(t, start.SpanContext().SpanID(), save.Parent().SpanID())
+	assert.Equal(t, game.ID, attributeValue(save, tracing.GameIDKey))
+
+	get := spanByName(spans, "GameService.GetGame")
+	require.NotNil(t, get)
+	assert.Equal(t, "Error", get.Status().Code.String())
+	assert.Equal(t, "intruder", attributeValue(get, tracing.UserIDKey))
+}
+
+func TestTracing_PropagatesFromGRPCMetadata(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	propagators := propagation.TraceContext{}
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler(
+		otelgrpc.WithTracerProvider(provider),
+		otelgrpc.WithPropagators(propagators),
+	)))
+	pb.RegisterTicTacToeServiceServer(server, handler.NewGRPCHandler(newTracedService(provider)))
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler(
+			otelgrpc.WithTracerProvider(provider),
+			otelgrpc.WithPropagators(propagators),
+		)),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, clientSpan := provider.Tracer("test").Start(context.Background(), "client")
+	_, err = pb.NewTicTacToeServiceClient(conn).StartGame(ctx, &pb.StartGameRequest{UserId: "player1"})
+	require.NoError(t, err)
+	clientSpan.End()
+	server.GracefulStop()
+
+	spans := recorder.Ended()
+	rpc := spanByName(spans, "tictactoe.TicTacToeService/StartGame")
+	svcSpan := spanByName(spans, "GameService.StartGame")
+	require.NotNil(t, svcSpan)
+
+	traceID := clientSpan.SpanContext().TraceID()
+	assert.Equal(t, traceID, svcSpan.SpanContext().TraceID())
+	require.NotNil(t, rpc)
+	assert.Equal(t, traceID, rpc.SpanContext().TraceID())
+}
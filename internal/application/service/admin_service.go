@@ -0,0 +1,135 @@
+// internal/application/service/admin_service.go
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+type adminService struct {
+	gameRepo  port.GameRepository
+	userRepo  port.UserRepository
+	startedAt time.Time
+	options
+}
+
+// NewAdminService returns the operator-facing service. Uptime is measured
+// from the time it is created.
+func NewAdminService(gameRepo port.GameRepository, userRepo port.UserRepository, opts ...Option) port.AdminService {
+	return &adminService{
+		gameRepo:  gameRepo,
+		userRepo:  userRepo,
+		startedAt: time.Now(),
+		options:   newOptions(opts),
+	}
+}
+
+func (s *adminService) ListGames(ctx context.Context, filter port.GameFilter) ([]*entity.Game, error) {
+	return s.gameRepo.FindGames(ctx, filter)
+}
+
+func (s *adminService) ForceEndGame(ctx context.Context, gameID, winnerID string, draw bool) (*entity.Game, error) {
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		return game.ForceEnd(winnerID, draw)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.PublishGameUpdated(ctx, game)
+	s.metrics.GameFinished(ctx, game)
+	s.logger.InfoContext(ctx, "game force-ended by admin",
+		slog.String("game_id", game.ID),
+		slog.String("status", game.Status.String()),
+		slog.String("winner_id", game.WinnerID))
+
+	if game.Status != entity.StatusAbandoned {
+		if err := recordResult(context.WithoutCancel(ctx), s.userRepo, game); err != nil {
+			s.logger.ErrorContext(ctx, "failed to update user stats",
+				slog.String("game_id", game.ID),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	return game, nil
+}
+
+func (s *adminService) DeleteGame(ctx context.Context, gameID string) error {
+	if _, err := s.gameRepo.FindByID(ctx, gameID); err != nil {
+		return err
+	}
+
+	if err := s.gameRepo.Delete(ctx, gameID); err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "game deleted by admin", slog.String("game_id", gameID))
+	return nil
+}
+
+func (s *adminService) ResetUserStats(ctx context.Context, userID string) (*entity.UserStats, error) {
+	if _, err := s.userRepo.FindStatsByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	stats := entity.NewUserStats(userID)
+	if err := s.userRepo.SaveStats(ctx, stats); err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "user stats reset by admin", slog.String("user_id", userID))
+	return stats, nil
+}
+
+func (s *adminService) AdjustUserStats(ctx context.Context, userID string, wins, losses, draws int) (*entity.UserStats, error) {
+	stats, err := s.userRepo.FindStatsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stats.Adjust(wins, losses, draws); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.SaveStats(ctx, stats); err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "user stats adjusted by admin",
+		slog.String("user_id", userID),
+		slog.Int("wins", wins),
+		slog.Int("losses", losses),
+		slog.Int("draws", draws))
+	return stats, nil
+}
+
+func (s *adminService) ServerStats(ctx context.Context) (*port.ServerStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	stats := &port.ServerStats{
+		Games:            s.gameRepo.Count(ctx),
+		GamesByStatus:    make(map[entity.GameStatus]int64),
+		ConnectedStreams: s.streams.ActiveStreams(),
+		Uptime:           time.Since(s.startedAt),
+	}
+	for _, status := range []entity.GameStatus{
+		entity.StatusPending,
+		entity.StatusInProgress,
+		entity.StatusFinishedWin,
+		entity.StatusFinishedDraw,
+		entity.StatusAbandoned,
+	} {
+		stats.GamesByStatus[status] = s.gameRepo.CountByStatus(ctx, status)
+	}
+	// The counts are 0 if ctx ended while they were taken
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
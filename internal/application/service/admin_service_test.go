@@ -0,0 +1,155 @@
+// internal/application/service/admin_service_test.go
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tictactoe/internal/adapters/repository"
+	"tictactoe/internal/domain/config"
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+type fixedStreamCounter int
+
+func (c fixedStreamCounter) ActiveStreams() int { return int(c) }
+
+func TestAdminService_ListGames(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	games := NewGameService(gameRepo, userRepo, config.DefaultConfig())
+	admin := NewAdminService(gameRepo, userRepo)
+	ctx := context.Background()
+
+	inProgress, _ := games.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	_, err := games.JoinGame(ctx, "player2", inProgress.ID)
+	require.NoError(t, err)
+	pending, _ := games.StartGame(ctx, "player3", entity.GameSettings{BoardSize: 4, WinningLength: 3})
+
+	all, err := admin.ListGames(ctx, port.GameFilter{})
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	status := entity.StatusInProgress
+	found, err := admin.ListGames(ctx, port.GameFilter{Status: &status})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, inProgress.ID, found[0].ID)
+
+	found, err = admin.ListGames(ctx, port.GameFilter{PlayerID: "player3", BoardSize: 4})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, pending.ID, found[0].ID)
+
+	found, err = admin.ListGames(ctx, port.GameFilter{CreatedBefore: time.Now().Add(-time.Hour)})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestAdminService_ForceEndGame(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	games := NewGameService(gameRepo, userRepo, config.DefaultConfig())
+	admin := NewAdminService(gameRepo, userRepo)
+	ctx := context.Background()
+
+	game, _ := games.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	game, _ = games.JoinGame(ctx, "player2", game.ID)
+
+	ended, err := admin.ForceEndGame(ctx, game.ID, "player2", false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StatusFinishedWin, ended.Status)
+
+	stats, err := games.GetUserStats(ctx, "player2")
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Wins)
+	stats, err = games.GetUserStats(ctx, "player1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Losses)
+
+	_, err = admin.ForceEndGame(ctx, game.ID, "", false)
+	assert.Equal(t, entity.ErrGameFinished, err)
+
+	// Abandoning does not touch stats.
+	pending, _ := games.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 5, WinningLength: 5})
+	ended, err = admin.ForceEndGame(ctx, pending.ID, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StatusAbandoned, ended.Status)
+	stats, _ = games.GetUserStats(ctx, "player1")
+	assert.Equal(t, 1, stats.TotalGames)
+}
+
+func TestAdminService_DeleteGame(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	games := NewGameService(gameRepo, userRepo, config.DefaultConfig())
+	admin := NewAdminService(gameRepo, userRepo)
+	ctx := context.Background()
+
+	game, _ := games.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	require.NoError(t, admin.DeleteGame(ctx, game.ID))
+
+	_, err := gameRepo.FindByID(ctx, game.ID)
+	assert.Equal(t, entity.ErrGameNotFound, err)
+	assert.Equal(t, entity.ErrGameNotFound, admin.DeleteGame(ctx, game.ID))
+}
+
+func TestAdminService_UserStats(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	admin := NewAdminService(gameRepo, userRepo)
+	ctx := context.Background()
+
+	_, err := admin.AdjustUserStats(ctx, "ghost", 1, 0, 0)
+	assert.Equal(t, entity.ErrUserNotFound, err)
+
+	require.NoError(t, userRepo.CreateUserIfNotExists(ctx, "player1"))
+
+	stats, err := admin.AdjustUserStats(ctx, "player1", 3, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 6, stats.TotalGames)
+
+	_, err = admin.AdjustUserStats(ctx, "player1", 0, -2, 0)
+	assert.Equal(t, entity.ErrInvalidAdjustment, err)
+
+	stats, err = admin.ResetUserStats(ctx, "player1")
+	require.NoError(t, err)
+	assert.Zero(t, stats.TotalGames)
+
+	stored, err := userRepo.FindStatsByUserID(ctx, "player1")
+	require.NoError(t, err)
+	assert.Zero(t, stored.Wins)
+}
+
+func TestAdminService_ServerStats(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	games := NewGameService(gameRepo, userRepo, config.DefaultConfig())
+	admin := NewAdminService(gameRepo, userRepo, WithStreamCounter(fixedStreamCounter(4)))
+	ctx := context.Background()
+
+	game, _ := games.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	_, _ = games.JoinGame(ctx, "player2", game.ID)
+	_, _ = games.StartGame(ctx, "player3", entity.GameSettings{BoardSize: 4, WinningLength: 4})
+
+	stats, err := admin.ServerStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Games)
+	assert.Equal(t, int64(1), stats.GamesByStatus[entity.StatusPending])
+	assert.Equal(t, int64(1), stats.GamesByStatus[entity.StatusInProgress])
+	assert.Equal(t, 4, stats.ConnectedStreams)
+	assert.Positive(t, stats.Uptime)
+
+	// A cancelled request counts nothing
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Zero(t, gameRepo.Count(cancelled))
+	assert.Zero(t, gameRepo.CountByStatus(cancelled, entity.StatusPending))
+	_, err = admin.ServerStats(cancelled)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -2,6 +2,14 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
 	"tictactoe/internal/domain/config"
 	"tictactoe/internal/domain/entity"
 	"tictactoe/internal/domain/port"
@@ -11,116 +19,396 @@ type gameService struct {
 	gameRepo port.GameRepository
 	userRepo port.UserRepository
 	config   *config.Config
+	options
+}
+
+// options holds the collaborators shared by the services in this package.
+type options struct {
+	logger     *slog.Logger
+	metrics    port.GameMetrics
+	events     port.GameEventPublisher
+	streams    port.StreamCounter
+	spectators port.SpectatorTracker
+}
+
+type Option func(*options)
+
+// WithLogger sets the logger used by the service. Defaults to slog.Default().
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
 }
 
-func NewGameService(gameRepo port.GameRepository, userRepo port.UserRepository, cfg *config.Config) port.GameService {
+// WithMetrics sets the recorder notified of game lifecycle events.
+func WithMetrics(metrics port.GameMetrics) Option {
+	return func(o *options) {
+		o.metrics = metrics
+	}
+}
+
+// WithEventPublisher sets the publisher notified of every game state change.
+func WithEventPublisher(events port.GameEventPublisher) Option {
+	return func(o *options) {
+		o.events = events
+	}
+}
+
+// WithStreamCounter sets the source of the connected stream count reported
+// by the admin service.
+func WithStreamCounter(streams port.StreamCounter) Option {
+	return func(o *options) {
+		o.streams = streams
+	}
+}
+
+// WithSpectatorTracker sets where the game service counts spectators. It
+// should be the broker that serves their streams.
+func WithSpectatorTracker(spectators port.SpectatorTracker) Option {
+	return func(o *options) {
+		o.spectators = spectators
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		logger:     slog.Default(),
+		metrics:    noopGameMetrics{},
+		events:     noopGameEventPublisher{},
+		streams:    noopStreamCounter{},
+		spectators: noopSpectatorTracker{},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+func NewGameService(gameRepo port.GameRepository, userRepo port.UserRepository, cfg *config.Config, opts ...Option) port.GameService {
 	return &gameService{
 		gameRepo: gameRepo,
 		userRepo: userRepo,
 		config:   cfg,
+		options:  newOptions(opts),
 	}
 }
 
-func (s *gameService) StartGame(userID string, boardSize, winningLength int) (*entity.Game, error) {
-	// Ensure user exists
-	if err := s.userRepo.CreateUserIfNotExists(userID); err != nil {
-		return nil, err
-	}
+// matchmakingCandidates bounds how many waiting games StartGame tries to
+// join before creating a new one.
+const matchmakingCandidates = 10
 
+func (s *gameService) StartGame(ctx context.Context, userID string, settings entity.GameSettings) (*entity.Game, error) {
 	// Validate and normalize parameters
-	boardSize = s.config.ValidateBoardSize(boardSize)
-	winningLength = s.config.ValidateWinningLength(winningLength, boardSize)
-
-	// Try to find an existing pending game with matching parameters
-	pendingGames, err := s.gameRepo.FindPendingGames(boardSize, winningLength)
+	settings, err := s.normalizeSettings(settings)
 	if err != nil {
 		return nil, err
 	}
 
-	// Look for a game that doesn't belong to the same user
+	// Ensure user exists
+	if err := s.userRepo.CreateUserIfNotExists(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	// Try to find an existing pending game with matching parameters that
+	// doesn't belong to the same user, longest-waiting first. Obstacles and
+	// handicap stones are never matched: the caller gets the board they set
+	// up, and players asking for a plain board get one.
+	var pendingGames []*entity.Game
+	if !settings.HasCustomSetup() {
+		ranked := settings.Ranked == nil || *settings.Ranked
+		pendingGames, _, err = s.gameRepo.FindPendingGames(ctx, port.PendingGameFilter{
+			BoardWidth:         settings.BoardWidth,
+			BoardHeight:        settings.BoardHeight,
+			WinningLength:      settings.WinningLength,
+			Variant:            settings.Variant,
+			Seats:              settings.Seats,
+			ExcludeCreatorID:   userID,
+			ExcludeCustomSetup: true,
+			Ranked:             &ranked,
+			Limit:              matchmakingCandidates,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, game := range pendingGames {
-		if game.Player1ID != userID {
-			// Join this existing game
-			if err := game.JoinPlayer(userID); err != nil {
-				continue // Try next game
-			}
+		// Join this existing game
+		if err := game.JoinPlayer(userID); err != nil {
+			continue // Try next game
+		}
 
-			if err := s.gameRepo.Save(game); err != nil {
-				return nil, err
-			}
+		err := s.gameRepo.Save(ctx, game)
+		if errors.Is(err, port.ErrConcurrentUpdate) {
+			continue // Someone else joined it first
+		}
+		if err != nil {
+			return nil, err
+		}
 
-			return game, nil
+		if game.Status == entity.StatusInProgress {
+			s.metrics.GameMatched(ctx, game)
 		}
+		s.events.PublishGameUpdated(ctx, game)
+		s.logger.InfoContext(ctx, "matched player into pending game",
+			slog.String("game_id", game.ID),
+			slog.String("user_id", userID),
+			slog.Int("players", len(game.Players)),
+			slog.Int("seats", game.Seats))
+		return game, nil
 	}
 
 	// No suitable pending game found, create a new one
-	game := entity.NewGame(userID, boardSize, winningLength)
-	if err := s.gameRepo.Save(game); err != nil {
+	game := entity.NewGameWithSettings(userID, settings)
+	if err := s.gameRepo.Save(ctx, game); err != nil {
 		return nil, err
 	}
 
+	s.logger.InfoContext(ctx, "game created",
+		slog.String("game_id", game.ID),
+		slog.String("user_id", userID),
+		slog.Int("board_width", game.BoardWidth),
+		slog.Int("board_height", game.BoardHeight),
+		slog.Int("winning_length", game.WinningLength),
+		slog.String("variant", game.Variant),
+		slog.Int("seats", game.Seats),
+		slog.Int("obstacles", len(game.Obstacles())),
+		slog.Int("handicap_stones", len(game.HandicapStones)),
+		slog.Bool("ranked", game.Ranked))
 	return game, nil
 }
 
-func (s *gameService) SearchPendingGames(boardSize, winningLength int) ([]*entity.Game, error) {
-	return s.gameRepo.FindPendingGames(boardSize, winningLength)
+// normalizeSettings checks that the variant exists and accepts settings,
+// and applies its requirements and the configured limits and defaults.
+func (s *gameService) normalizeSettings(settings entity.GameSettings) (entity.GameSettings, error) {
+	rules, err := entity.LookupVariant(settings.Variant)
+	if err != nil {
+		return settings, err
+	}
+	if validator, ok := rules.(entity.SettingsValidator); ok {
+		if err := validator.ValidateSettings(settings); err != nil {
+			return settings, err
+		}
+	}
+	if adjuster, ok := rules.(entity.SettingsAdjuster); ok {
+		settings = adjuster.AdjustSettings(settings)
+	}
+	if settings.Variant == "" {
+		settings.Variant = entity.VariantStandard
+	}
+	if settings.Seats == 0 {
+		settings.Seats = 2
+	}
+	if settings.Seats < 2 || settings.Seats > entity.MaxSeats {
+		return settings, entity.ErrInvalidSeats
+	}
+	width, height, err := s.config.ValidateBoardDimensions(settings.Dimensions())
+	if err != nil {
+		return settings, err
+	}
+	settings.BoardSize = 0
+	settings.BoardWidth, settings.BoardHeight = width, height
+	settings.WinningLength = s.config.ValidateWinningLength(settings.WinningLength, settings.BoardWidth, settings.BoardHeight)
+	if err := settings.ValidatePlacements(); err != nil {
+		return settings, err
+	}
+	return settings, nil
 }
 
-func (s *gameService) JoinGame(userID, gameID string) (*entity.Game, error) {
-	// Ensure user exists
-	if err := s.userRepo.CreateUserIfNotExists(userID); err != nil {
-		return nil, err
-	}
+// maxJoinCodeAttempts bounds the retries after a join code collision.
+const maxJoinCodeAttempts = 5
 
-	game, err := s.gameRepo.FindByID(gameID)
+func (s *gameService) StartPrivateGame(ctx context.Context, userID string, settings entity.GameSettings, invitedUserID string) (*entity.Game, error) {
+	settings, err := s.normalizeSettings(settings)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := game.JoinPlayer(userID); err != nil {
+	if err := s.userRepo.CreateUserIfNotExists(ctx, userID); err != nil {
 		return nil, err
 	}
 
-	if err := s.gameRepo.Save(game); err != nil {
+	game := entity.NewPrivateGame(userID, settings, invitedUserID)
+	for attempt := 1; ; attempt++ {
+		_, err := s.gameRepo.FindByJoinCode(ctx, game.JoinCode)
+		if errors.Is(err, entity.ErrGameNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if attempt == maxJoinCodeAttempts {
+			return nil, errors.New("failed to generate a unique join code")
+		}
+		game.JoinCode = entity.NewJoinCode()
+	}
+
+	if err := s.gameRepo.Save(ctx, game); err != nil {
 		return nil, err
 	}
 
+	s.logger.InfoContext(ctx, "private game created",
+		slog.String("game_id", game.ID),
+		slog.String("user_id", userID),
+		slog.String("invited_user_id", invitedUserID),
+		slog.Int("board_width", game.BoardWidth),
+		slog.Int("board_height", game.BoardHeight),
+		slog.Int("winning_length", game.WinningLength),
+		slog.String("variant", game.Variant),
+		slog.Int("seats", game.Seats),
+		slog.Int("obstacles", len(game.Obstacles())),
+		slog.Int("handicap_stones", len(game.HandicapStones)),
+		slog.Bool("ranked", game.Ranked))
 	return game, nil
 }
 
-func (s *gameService) MakeMove(userID, gameID string, row, col int) (*entity.Game, error) {
-	game, err := s.gameRepo.FindByID(gameID)
+func (s *gameService) SearchPendingGames(ctx context.Context, query port.PendingGameQuery) (*port.GamePage, error) {
+	if query.Order == port.OrderCreatorWins {
+		// Creator wins live in the user repository, so every match is
+		// fetched and sorted here
+		games, _, err := s.gameRepo.FindPendingGames(ctx, query.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.sortByCreatorWins(ctx, games); err != nil {
+			return nil, err
+		}
+		return paginate(games, query.PageToken, query.PageSize)
+	}
+
+	offset, size, err := pageBounds(query.PageToken, query.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	filter := query.Filter
+	filter.NewestFirst = query.Order == port.OrderNewestFirst
+	filter.Offset = offset
+	filter.Limit = size
+	games, total, err := s.gameRepo.FindPendingGames(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
+	return newPage(games, offset, total), nil
+}
 
-	if !game.IsPlayerInGame(userID) {
-		return nil, entity.ErrPlayerNotInGame
+// sortByCreatorWins orders games whose creators have the most wins first,
+// keeping the oldest-first order among creators with equal wins.
+func (s *gameService) sortByCreatorWins(ctx context.Context, games []*entity.Game) error {
+	wins := make(map[string]int)
+	for _, game := range games {
+		if _, ok := wins[game.Player1ID]; ok {
+			continue
+		}
+		stats, err := s.userRepo.FindStatsByUserID(ctx, game.Player1ID)
+		switch {
+		case errors.Is(err, entity.ErrUserNotFound):
+			wins[game.Player1ID] = 0
+		case err != nil:
+			return err
+		default:
+			wins[game.Player1ID] = stats.Wins
+		}
 	}
+	sort.SliceStable(games, func(i, j int) bool {
+		return wins[games[i].Player1ID] > wins[games[j].Player1ID]
+	})
+	return nil
+}
 
-	pos := entity.Position{Row: row, Col: col}
-	if err := game.MakeMove(userID, pos); err != nil {
+func (s *gameService) JoinGame(ctx context.Context, userID, gameID string) (*entity.Game, error) {
+	// Ensure user exists
+	if err := s.userRepo.CreateUserIfNotExists(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		return game.JoinPlayer(userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.joinedGame(ctx, game, userID), nil
+}
+
+func (s *gameService) JoinGameByCode(ctx context.Context, userID, joinCode string) (*entity.Game, error) {
+	// Ensure user exists
+	if err := s.userRepo.CreateUserIfNotExists(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	// Codes are shared by hand, so be lenient about case and whitespace
+	joinCode = strings.ToUpper(strings.TrimSpace(joinCode))
+	game, err := s.gameRepo.FindByJoinCode(ctx, joinCode)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err = updateGame(ctx, s.gameRepo, game.ID, func(game *entity.Game) error {
+		return game.JoinPlayerWithCode(userID, joinCode)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// Update game
-	if err := s.gameRepo.Save(game); err != nil {
+	return s.joinedGame(ctx, game, userID), nil
+}
+
+// joinedGame reports a game userID has just been saved into.
+func (s *gameService) joinedGame(ctx context.Context, game *entity.Game, userID string) *entity.Game {
+	if game.Status == entity.StatusInProgress {
+		s.metrics.GameMatched(ctx, game)
+	}
+	s.events.PublishGameUpdated(ctx, game)
+	s.logger.InfoContext(ctx, "player joined game",
+		slog.String("game_id", game.ID),
+		slog.String("user_id", userID))
+	return game
+}
+
+func (s *gameService) MakeMove(ctx context.Context, userID, gameID string, row, col int) (*entity.Game, error) {
+	pos := entity.Position{Row: row, Col: col}
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		if !game.IsPlayerInGame(userID) {
+			return entity.ErrPlayerNotInGame
+		}
+		return game.MakeMove(userID, pos)
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	s.events.PublishGameUpdated(ctx, game)
+
 	// Update user statistics if game is finished
 	if game.Status == entity.StatusFinishedWin || game.Status == entity.StatusFinishedDraw {
-		if err := s.updateUserStats(game); err != nil {
-			// Log error but don't fail the move
-			// In production, this would be logged properly
-		}
+		s.gameFinished(ctx, game)
 	}
 
 	return game, nil
 }
 
-func (s *gameService) GetGame(gameID, userID string) (*entity.Game, error) {
-	game, err := s.gameRepo.FindByID(gameID)
+// gameFinished records the result of a game that has just been saved as
+// finished.
+func (s *gameService) gameFinished(ctx context.Context, game *entity.Game) {
+	s.metrics.GameFinished(ctx, game)
+	s.logger.InfoContext(ctx, "game finished",
+		slog.String("game_id", game.ID),
+		slog.String("status", game.Status.String()),
+		slog.String("winner_id", game.WinnerID))
+
+	// The game is already committed, so record stats even if the caller
+	// has gone away in the meantime.
+	if err := recordResult(context.WithoutCancel(ctx), s.userRepo, game); err != nil {
+		// Log error but don't fail the request
+		s.logger.ErrorContext(ctx, "failed to update user stats",
+			slog.String("game_id", game.ID),
+			slog.String("error", err.Error()))
+	}
+}
+
+func (s *gameService) GetGame(ctx context.Context, gameID, userID string) (*entity.Game, error) {
+	game, err := s.gameRepo.FindByID(ctx, gameID)
 	if err != nil {
 		return nil, err
 	}
@@ -133,11 +421,14 @@ func (s *gameService) GetGame(gameID, userID string) (*entity.Game, error) {
 	return game, nil
 }
 
-func (s *gameService) GetUserStats(userID string) (*entity.UserStats, error) {
-	stats, err := s.userRepo.FindStatsByUserID(userID)
+func (s *gameService) GetUserStats(ctx context.Context, userID string) (*entity.UserStats, error) {
+	stats, err := s.userRepo.FindStatsByUserID(ctx, userID)
 	if err != nil {
+		if !errors.Is(err, entity.ErrUserNotFound) {
+			return nil, err
+		}
 		// If user doesn't exist, create new stats
-		if err := s.userRepo.CreateUserIfNotExists(userID); err != nil {
+		if err := s.userRepo.CreateUserIfNotExists(ctx, userID); err != nil {
 			return nil, err
 		}
 		stats = entity.NewUserStats(userID)
@@ -146,40 +437,352 @@ func (s *gameService) GetUserStats(userID string) (*entity.UserStats, error) {
 	return stats, nil
 }
 
-func (s *gameService) updateUserStats(game *entity.Game) error {
-	// Get or create stats for both players
-	player1Stats, err := s.userRepo.FindStatsByUserID(game.Player1ID)
+func (s *gameService) ListUserGames(ctx context.Context, userID string, statuses []entity.GameStatus, pageToken string, pageSize int) (*port.GamePage, error) {
+	games, err := s.gameRepo.FindGamesByPlayer(ctx, userID)
 	if err != nil {
-		player1Stats = entity.NewUserStats(game.Player1ID)
+		return nil, err
+	}
+
+	if len(statuses) > 0 {
+		games = slices.DeleteFunc(games, func(g *entity.Game) bool {
+			return !slices.Contains(statuses, g.Status)
+		})
 	}
+	sortUserGames(games, userID)
 
-	player2Stats, err := s.userRepo.FindStatsByUserID(game.Player2ID)
+	return paginate(games, pageToken, pageSize)
+}
+
+func (s *gameService) SpectateGame(ctx context.Context, gameID, userID string) (*entity.Game, error) {
+	game, err := s.gameRepo.FindByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	// Players watch their own game without counting as spectators
+	if game.IsPlayerInGame(userID) {
+		return game, nil
+	}
+	if !game.CanSpectate(userID) {
+		return nil, entity.ErrSpectatingClosed
+	}
+
+	// Spectators are tracked outside the game, so watching never saves it
+	s.spectators.AddSpectator(gameID, userID)
+	s.logger.DebugContext(ctx, "spectator joined",
+		slog.String("game_id", gameID),
+		slog.String("user_id", userID),
+		slog.Int("spectators", s.spectators.SpectatorCount(gameID)))
+	return game, nil
+}
+
+func (s *gameService) StopSpectating(ctx context.Context, gameID, userID string) error {
+	s.spectators.RemoveSpectator(gameID, userID)
+	s.logger.DebugContext(ctx, "spectator left",
+		slog.String("game_id", gameID),
+		slog.String("user_id", userID),
+		slog.Int("spectators", s.spectators.SpectatorCount(gameID)))
+	return nil
+}
+
+func (s *gameService) SetSpectatorsAllowed(ctx context.Context, userID, gameID string, allowed bool) (*entity.Game, error) {
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		return game.SetSpectatorsAllowed(userID, allowed)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		s.spectators.ClearSpectators(game.ID)
+	}
+
+	// Open spectator streams see the change and close if no longer allowed
+	s.events.PublishGameUpdated(ctx, game)
+	s.logger.InfoContext(ctx, "spectator policy changed",
+		slog.String("game_id", game.ID),
+		slog.Bool("spectators_allowed", allowed))
+	return game, nil
+}
+
+func (s *gameService) ListLiveGames(ctx context.Context, pageToken string, pageSize int) (*port.GamePage, error) {
+	inProgress := entity.StatusInProgress
+	games, err := s.gameRepo.FindGames(ctx, port.GameFilter{Status: &inProgress})
+	if err != nil {
+		return nil, err
+	}
+
+	games = slices.DeleteFunc(games, func(g *entity.Game) bool {
+		return g.Private || !g.SpectatorsAllowed
+	})
+	watching := make(map[string]int, len(games))
+	for _, game := range games {
+		watching[game.ID] = s.spectators.SpectatorCount(game.ID)
+	}
+	sort.SliceStable(games, func(i, j int) bool {
+		return watching[games[i].ID] > watching[games[j].ID]
+	})
+
+	return paginate(games, pageToken, pageSize)
+}
+
+func (s *gameService) OfferRematch(ctx context.Context, userID, gameID string) (*entity.Game, error) {
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		return game.OfferRematch(userID, time.Now().Add(s.config.RematchOfferTTL))
+	})
 	if err != nil {
-		player2Stats = entity.NewUserStats(game.Player2ID)
+		return nil, err
 	}
 
-	// Update based on game outcome
-	switch game.Status {
-	case entity.StatusFinishedWin:
-		if game.WinnerID == game.Player1ID {
-			player1Stats.RecordWin()
-			player2Stats.RecordLoss()
-		} else {
-			player2Stats.RecordWin()
-			player1Stats.RecordLoss()
+	// Close the offer when it lapses so that both players hear about it
+	expireCtx := context.WithoutCancel(ctx)
+	time.AfterFunc(s.config.RematchOfferTTL, func() { s.expireRematch(expireCtx, gameID) })
+
+	s.events.PublishGameUpdated(ctx, game)
+	s.logger.InfoContext(ctx, "rematch offered",
+		slog.String("game_id", game.ID),
+		slog.String("user_id", userID))
+	return game, nil
+}
+
+func (s *gameService) expireRematch(ctx context.Context, gameID string) {
+	// Only an offer still open is closed: if it was answered or renewed in
+	// the meantime, the stored game is left as it is
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		if !game.ExpireRematch(time.Now()) {
+			return errUnchanged
 		}
-	case entity.StatusFinishedDraw:
-		player1Stats.RecordDraw()
-		player2Stats.RecordDraw()
+		return nil
+	})
+	if errors.Is(err, errUnchanged) {
+		return
 	}
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to expire rematch offer",
+			slog.String("game_id", gameID),
+			slog.String("error", err.Error()))
+		return
+	}
+
+	s.events.PublishGameUpdated(ctx, game)
+	s.logger.InfoContext(ctx, "rematch offer expired", slog.String("game_id", game.ID))
+}
 
-	// Save updated stats
-	if err := s.userRepo.SaveStats(player1Stats); err != nil {
+func (s *gameService) AcceptRematch(ctx context.Context, userID, gameID string) (*entity.Game, error) {
+	// The finished game is saved first, so only one acceptance creates a
+	// rematch
+	var (
+		offer   entity.Rematch
+		rematch *entity.Game
+	)
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		offer = game.Rematch
+		var err error
+		rematch, err = game.AcceptRematch(userID, time.Now())
 		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	if err := s.userRepo.SaveStats(player2Stats); err != nil {
-		return err
+	if err := s.gameRepo.Save(ctx, rematch); err != nil {
+		s.reopenRematchOffer(ctx, gameID, offer, rematch.ID)
+		return nil, err
+	}
+
+	// A rematch starts with both seats filled and never waits in
+	// matchmaking, so it is not recorded as a match. Players following the
+	// finished game learn the new game's ID from it
+	s.events.PublishGameUpdated(ctx, game)
+	s.events.PublishGameUpdated(ctx, rematch)
+	s.logger.InfoContext(ctx, "rematch accepted",
+		slog.String("game_id", game.ID),
+		slog.String("rematch_game_id", rematch.ID),
+		slog.String("user_id", userID))
+	return rematch, nil
+}
+
+// reopenRematchOffer puts back the offer an acceptance consumed when its
+// rematch could not be saved, so the finished game does not point at a game
+// that does not exist and the offer can be accepted again.
+func (s *gameService) reopenRematchOffer(ctx context.Context, gameID string, offer entity.Rematch, rematchID string) {
+	// The acceptance has failed either way, so finish even if the caller
+	// has gone away
+	_, err := updateGame(context.WithoutCancel(ctx), s.gameRepo, gameID, func(game *entity.Game) error {
+		if game.Rematch.GameID != rematchID {
+			return errUnchanged
+		}
+		game.Rematch = offer
+		return nil
+	})
+	if err != nil && !errors.Is(err, errUnchanged) {
+		s.logger.ErrorContext(ctx, "failed to reopen rematch offer",
+			slog.String("game_id", gameID),
+			slog.String("rematch_game_id", rematchID),
+			slog.String("error", err.Error()))
+	}
+}
+
+func (s *gameService) DeclineRematch(ctx context.Context, userID, gameID string) (*entity.Game, error) {
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		return game.DeclineRematch(userID, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.PublishGameUpdated(ctx, game)
+	s.logger.InfoContext(ctx, "rematch declined",
+		slog.String("game_id", game.ID),
+		slog.String("user_id", userID))
+	return game, nil
+}
+
+func (s *gameService) OfferDraw(ctx context.Context, userID, gameID string) (*entity.Game, error) {
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		return game.OfferDraw(userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.PublishGameUpdated(ctx, game)
+	s.logger.InfoContext(ctx, "draw offered",
+		slog.String("game_id", game.ID),
+		slog.String("user_id", userID))
+	return game, nil
+}
+
+func (s *gameService) AcceptDraw(ctx context.Context, userID, gameID string) (*entity.Game, error) {
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		return game.AcceptDraw(userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.PublishGameUpdated(ctx, game)
+	s.gameFinished(ctx, game)
+	return game, nil
+}
+
+func (s *gameService) DeclineDraw(ctx context.Context, userID, gameID string) (*entity.Game, error) {
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		return game.DeclineDraw(userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.PublishGameUpdated(ctx, game)
+	s.logger.InfoContext(ctx, "draw declined",
+		slog.String("game_id", game.ID),
+		slog.String("user_id", userID))
+	return game, nil
+}
+
+func (s *gameService) RequestTakeback(ctx context.Context, userID, gameID string) (*entity.Game, error) {
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		return game.RequestTakeback(userID, s.config.MaxTakebacks)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.PublishGameUpdated(ctx, game)
+	s.logger.InfoContext(ctx, "takeback requested",
+		slog.String("game_id", game.ID),
+		slog.String("user_id", userID))
+	return game, nil
+}
+
+func (s *gameService) RespondTakeback(ctx context.Context, userID, gameID string, accept bool) (*entity.Game, error) {
+	game, err := updateGame(ctx, s.gameRepo, gameID, func(game *entity.Game) error {
+		return game.RespondTakeback(userID, accept)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.PublishGameUpdated(ctx, game)
+	s.logger.InfoContext(ctx, "takeback answered",
+		slog.String("game_id", game.ID),
+		slog.String("user_id", userID),
+		slog.Bool("accepted", accept))
+	return game, nil
+}
+
+// sortUserGames orders games awaiting the user's move first, then the other
+// unfinished games, then finished games, each group most recently updated
+// first.
+func sortUserGames(games []*entity.Game, userID string) {
+	group := func(g *entity.Game) int {
+		switch {
+		case g.IsTurnOf(userID):
+			return 0
+		case !g.IsFinished():
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.Slice(games, func(i, j int) bool {
+		gi, gj := group(games[i]), group(games[j])
+		if gi != gj {
+			return gi < gj
+		}
+		if !games[i].UpdatedAt.Equal(games[j].UpdatedAt) {
+			return games[i].UpdatedAt.After(games[j].UpdatedAt)
+		}
+		return games[i].ID < games[j].ID
+	})
+}
+
+// recordResult updates every player's stats for a finished game: the winner
+// gets a win and everyone else a loss, or they all get a draw.
+func recordResult(ctx context.Context, userRepo port.UserRepository, game *entity.Game) error {
+	for _, playerID := range game.Players {
+		// Get or create stats for the player
+		stats, err := userRepo.FindStatsByUserID(ctx, playerID)
+		if errors.Is(err, entity.ErrUserNotFound) {
+			stats = entity.NewUserStats(playerID)
+		} else if err != nil {
+			return err
+		}
+
+		// Update based on game outcome
+		switch {
+		case game.Status == entity.StatusFinishedDraw:
+			stats.RecordDraw(game.Variant)
+		case game.Status != entity.StatusFinishedWin:
+			continue
+		case game.WinnerID == playerID:
+			stats.RecordWin(game.Variant)
+		default:
+			stats.RecordLoss(game.Variant)
+		}
+
+		if err := userRepo.SaveStats(ctx, stats); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+type noopGameMetrics struct{}
+
+func (noopGameMetrics) GameMatched(context.Context, *entity.Game)  {}
+func (noopGameMetrics) GameFinished(context.Context, *entity.Game) {}
+
+type noopGameEventPublisher struct{}
+
+func (noopGameEventPublisher) PublishGameUpdated(context.Context, *entity.Game) {}
+
+type noopStreamCounter struct{}
+
+func (noopStreamCounter) ActiveStreams() int { return 0 }
+
+type noopSpectatorTracker struct{}
+
+func (noopSpectatorTracker) AddSpectator(string, string)    {}
+func (noopSpectatorTracker) RemoveSpectator(string, string) {}
+func (noopSpectatorTracker) ClearSpectators(string)         {}
+func (noopSpectatorTracker) SpectatorCount(string) int      { return 0 }
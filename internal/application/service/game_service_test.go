@@ -2,14 +2,20 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"tictactoe/internal/adapters/pubsub"
 	"tictactoe/internal/adapters/repository"
 	"tictactoe/internal/domain/config"
 	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
 )
 
 func TestGameService_StartGame(t *testing.T) {
@@ -17,22 +23,23 @@ func TestGameService_StartGame(t *testing.T) {
 	userRepo := repository.NewInMemoryUserRepository()
 	cfg := config.DefaultConfig()
 	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
 
 	// Test creating new game
-	game, err := service.StartGame("player1", 3, 3)
+	game, err := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
 	require.NoError(t, err)
 	assert.Equal(t, entity.StatusPending, game.Status)
 	assert.Equal(t, "player1", game.Player1ID)
 
 	// Test joining existing game
-	game2, err := service.StartGame("player2", 3, 3)
+	game2, err := service.StartGame(ctx, "player2", entity.GameSettings{BoardSize: 3, WinningLength: 3})
 	require.NoError(t, err)
 	assert.Equal(t, entity.StatusInProgress, game2.Status)
 	assert.Equal(t, game.ID, game2.ID) // Same game
 	assert.Equal(t, "player2", game2.Player2ID)
 
 	// Test get game with different player
-	_, err = service.GetGame(game2.ID, "player3")
+	_, err = service.GetGame(ctx, game2.ID, "player3")
 	assert.Equal(t, entity.ErrPlayerNotInGame, err)
 }
 
@@ -41,27 +48,28 @@ func TestGameService_MakeMove(t *testing.T) {
 	userRepo := repository.NewInMemoryUserRepository()
 	cfg := config.DefaultConfig()
 	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
 
 	// Setup game
-	game, _ := service.StartGame("player1", 3, 3)
-	game, _ = service.JoinGame("player2", game.ID)
+	game, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	game, _ = service.JoinGame(ctx, "player2", game.ID)
 
 	// Test valid move - player 1
-	game, err := service.MakeMove("player1", game.ID, 0, 0)
+	game, err := service.MakeMove(ctx, "player1", game.ID, 0, 0)
 	require.NoError(t, err)
 	assert.Equal(t, "X", game.Board[0][0])
 
 	// Test invalid move
-	_, err = service.MakeMove("player1", game.ID, 0, 0)
+	_, err = service.MakeMove(ctx, "player1", game.ID, 0, 0)
 	assert.Equal(t, entity.ErrNotPlayersTurn, err)
 
 	// Test valid move - player 2
-	game, err = service.MakeMove("player2", game.ID, 1, 0)
+	game, err = service.MakeMove(ctx, "player2", game.ID, 1, 0)
 	require.NoError(t, err)
 	assert.Equal(t, "O", game.Board[1][0])
 
 	// Test Invalid move - player 1
-	_, err = service.MakeMove("player1", game.ID, 0, 0)
+	_, err = service.MakeMove(ctx, "player1", game.ID, 0, 0)
 	assert.Equal(t, entity.ErrPositionOccupied, err)
 }
 
@@ -70,9 +78,10 @@ func TestGameService_GetUserStats(t *testing.T) {
 	userRepo := repository.NewInMemoryUserRepository()
 	cfg := config.DefaultConfig()
 	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
 
 	// Test getting stats for new user
-	stats, err := service.GetUserStats("newuser")
+	stats, err := service.GetUserStats(ctx, "newuser")
 	require.NoError(t, err)
 	assert.Equal(t, "newuser", stats.UserID)
 	assert.Equal(t, 0, stats.TotalGames)
@@ -83,28 +92,29 @@ func TestGameService_CompleteGame(t *testing.T) {
 	userRepo := repository.NewInMemoryUserRepository()
 	cfg := config.DefaultConfig()
 	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
 
 	// Setup and complete a game
-	game, _ := service.StartGame("player1", 3, 3)
-	game, _ = service.JoinGame("player2", game.ID)
+	game, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	game, _ = service.JoinGame(ctx, "player2", game.ID)
 
 	// Player1 wins
-	service.MakeMove("player1", game.ID, 0, 0)           // X
-	service.MakeMove("player2", game.ID, 1, 0)           // O
-	service.MakeMove("player1", game.ID, 0, 1)           // X
-	service.MakeMove("player2", game.ID, 1, 1)           // O
-	game, _ = service.MakeMove("player1", game.ID, 0, 2) // X wins
+	service.MakeMove(ctx, "player1", game.ID, 0, 0)           // X
+	service.MakeMove(ctx, "player2", game.ID, 1, 0)           // O
+	service.MakeMove(ctx, "player1", game.ID, 0, 1)           // X
+	service.MakeMove(ctx, "player2", game.ID, 1, 1)           // O
+	game, _ = service.MakeMove(ctx, "player1", game.ID, 0, 2) // X wins
 
 	assert.Equal(t, entity.StatusFinishedWin, game.Status)
 	assert.Equal(t, "player1", game.WinnerID)
 
 	// Check ErrGameFinished on further moves
-	_, err := service.MakeMove("player2", game.ID, 2, 2)
+	_, err := service.MakeMove(ctx, "player2", game.ID, 2, 2)
 	assert.Equal(t, entity.ErrGameFinished, err)
 
 	// Check stats
-	stats1, _ := service.GetUserStats("player1")
-	stats2, _ := service.GetUserStats("player2")
+	stats1, _ := service.GetUserStats(ctx, "player1")
+	stats2, _ := service.GetUserStats(ctx, "player2")
 
 	assert.Equal(t, 1, stats1.Wins)
 	assert.Equal(t, 0, stats1.Losses)
@@ -114,3 +124,838 @@ func TestGameService_CompleteGame(t *testing.T) {
 	assert.Equal(t, 1, stats2.Losses)
 	assert.Equal(t, 1, stats2.TotalGames)
 }
+
+func TestGameService_CancelledContext(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+
+	game, err := service.StartGame(context.Background(), "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = service.StartGame(ctx, "player2", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = service.JoinGame(ctx, "player2", game.ID)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = service.GetUserStats(ctx, "player1")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// Nothing was changed by the cancelled calls
+	game, err = service.GetGame(context.Background(), game.ID, "player1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.StatusPending, game.Status)
+	assert.Empty(t, game.Player2ID)
+}
+
+func TestGameService_ListUserGames(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	// A finished game, a game awaiting player2, a game awaiting player1
+	// and a pending game created by player1.
+	finished, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	_, _ = service.JoinGame(ctx, "player2", finished.ID)
+	for _, move := range [][3]any{{"player1", 0, 0}, {"player2", 1, 0}, {"player1", 0, 1}, {"player2", 1, 1}, {"player1", 0, 2}} {
+		_, err := service.MakeMove(ctx, move[0].(string), finished.ID, move[1].(int), move[2].(int))
+		require.NoError(t, err)
+	}
+
+	theirTurn, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 4, WinningLength: 4})
+	_, _ = service.JoinGame(ctx, "player2", theirTurn.ID)
+	_, err := service.MakeMove(ctx, "player1", theirTurn.ID, 0, 0)
+	require.NoError(t, err)
+
+	myTurn, _ := service.StartGame(ctx, "player2", entity.GameSettings{BoardSize: 5, WinningLength: 5})
+	_, _ = service.JoinGame(ctx, "player1", myTurn.ID)
+	_, err = service.MakeMove(ctx, "player2", myTurn.ID, 0, 0)
+	require.NoError(t, err)
+
+	pending, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 6, WinningLength: 6})
+
+	page, err := service.ListUserGames(ctx, "player1", nil, "", 0)
+	require.NoError(t, err)
+	require.Len(t, page.Games, 4)
+	assert.Empty(t, page.NextPageToken)
+	assert.Equal(t, myTurn.ID, page.Games[0].ID)
+	assert.Equal(t, pending.ID, page.Games[1].ID)
+	assert.Equal(t, theirTurn.ID, page.Games[2].ID)
+	assert.Equal(t, finished.ID, page.Games[3].ID)
+
+	// Status filter
+	page, err = service.ListUserGames(ctx, "player1", []entity.GameStatus{entity.StatusFinishedWin}, "", 0)
+	require.NoError(t, err)
+	require.Len(t, page.Games, 1)
+	assert.Equal(t, finished.ID, page.Games[0].ID)
+
+	// Pagination
+	var ids []string
+	token := ""
+	for {
+		page, err := service.ListUserGames(ctx, "player1", nil, token, 3)
+		require.NoError(t, err)
+		for _, game := range page.Games {
+			ids = append(ids, game.ID)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+	assert.Equal(t, []string{myTurn.ID, pending.ID, theirTurn.ID, finished.ID}, ids)
+
+	_, err = service.ListUserGames(ctx, "player1", nil, "not-a-token", 3)
+	assert.ErrorIs(t, err, port.ErrInvalidPageToken)
+
+	// Unknown users simply have no games
+	page, err = service.ListUserGames(ctx, "nobody", nil, "", 0)
+	require.NoError(t, err)
+	assert.Empty(t, page.Games)
+}
+
+func TestGameService_SearchPendingGames(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	// Pending games on different boards so that none are matched together
+	oldest, _ := service.StartGame(ctx, "alice", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	middle, _ := service.StartGame(ctx, "bob", entity.GameSettings{BoardSize: 4, WinningLength: 4})
+	newest, _ := service.StartGame(ctx, "carol", entity.GameSettings{BoardSize: 5, WinningLength: 5})
+
+	bobStats := entity.NewUserStats("bob")
+	bobStats.RecordWin(entity.VariantStandard)
+	require.NoError(t, userRepo.SaveStats(ctx, bobStats))
+
+	ids := func(page *port.GamePage) []string {
+		var ids []string
+		for _, game := range page.Games {
+			ids = append(ids, game.ID)
+		}
+		return ids
+	}
+
+	page, err := service.SearchPendingGames(ctx, port.PendingGameQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{oldest.ID, middle.ID, newest.ID}, ids(page))
+	assert.Equal(t, 3, page.TotalSize)
+
+	page, err = service.SearchPendingGames(ctx, port.PendingGameQuery{Order: port.OrderNewestFirst})
+	require.NoError(t, err)
+	assert.Equal(t, []string{newest.ID, middle.ID, oldest.ID}, ids(page))
+
+	page, err = service.SearchPendingGames(ctx, port.PendingGameQuery{Order: port.OrderCreatorWins})
+	require.NoError(t, err)
+	assert.Equal(t, []string{middle.ID, oldest.ID, newest.ID}, ids(page))
+
+	// Filters
+	page, err = service.SearchPendingGames(ctx, port.PendingGameQuery{Filter: port.PendingGameFilter{CreatorID: "carol"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{newest.ID}, ids(page))
+
+	page, err = service.SearchPendingGames(ctx, port.PendingGameQuery{Filter: port.PendingGameFilter{ExcludeCreatorID: "alice"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{middle.ID, newest.ID}, ids(page))
+
+	page, err = service.SearchPendingGames(ctx, port.PendingGameQuery{Filter: port.PendingGameFilter{CreatedAfter: middle.CreatedAt}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{newest.ID}, ids(page))
+
+	// Pagination
+	page, err = service.SearchPendingGames(ctx, port.PendingGameQuery{PageSize: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{oldest.ID, middle.ID}, ids(page))
+	assert.Equal(t, 3, page.TotalSize)
+	require.NotEmpty(t, page.NextPageToken)
+
+	page, err = service.SearchPendingGames(ctx, port.PendingGameQuery{PageSize: 2, PageToken: page.NextPageToken})
+	require.NoError(t, err)
+	assert.Equal(t, []string{newest.ID}, ids(page))
+	assert.Empty(t, page.NextPageToken)
+
+	// Joined games leave the index
+	_, err = service.JoinGame(ctx, "dave", oldest.ID)
+	require.NoError(t, err)
+	page, err = service.SearchPendingGames(ctx, port.PendingGameQuery{Filter: port.PendingGameFilter{BoardSize: 3}})
+	require.NoError(t, err)
+	assert.Empty(t, page.Games)
+	assert.Zero(t, page.TotalSize)
+}
+
+func TestGameService_SearchPendingGames_Pages(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	// Games waiting on two boards, created alternately by two users
+	start := time.Now().Add(-time.Hour)
+	var created []string
+	for i := range 6 {
+		creator := []string{"alice", "bob"}[i%2]
+		game := entity.NewGameWithSettings(creator, entity.GameSettings{BoardSize: 3 + i%2, WinningLength: 3})
+		game.CreatedAt = start.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, gameRepo.Save(ctx, game))
+		created = append(created, game.ID)
+	}
+
+	collect := func(query port.PendingGameQuery) []string {
+		var ids []string
+		for {
+			page, err := service.SearchPendingGames(ctx, query)
+			require.NoError(t, err)
+			for _, game := range page.Games {
+				ids = append(ids, game.ID)
+			}
+			if page.NextPageToken == "" {
+				return ids
+			}
+			query.PageToken = page.NextPageToken
+		}
+	}
+
+	// Pages merge both boards in creation order
+	assert.Equal(t, created, collect(port.PendingGameQuery{PageSize: 4}))
+	assert.Equal(t, []string{created[5], created[4], created[3], created[2], created[1], created[0]},
+		collect(port.PendingGameQuery{Order: port.OrderNewestFirst, PageSize: 4}))
+
+	// Within one board
+	assert.Equal(t, []string{created[4], created[2], created[0]},
+		collect(port.PendingGameQuery{Order: port.OrderNewestFirst, PageSize: 2, Filter: port.PendingGameFilter{BoardSize: 3}}))
+
+	// Excluded and selected creators
+	page, err := service.SearchPendingGames(ctx, port.PendingGameQuery{PageSize: 2, Filter: port.PendingGameFilter{ExcludeCreatorID: "alice"}})
+	require.NoError(t, err)
+	assert.Equal(t, 3, page.TotalSize)
+	assert.Equal(t, []string{created[1], created[3], created[5]},
+		collect(port.PendingGameQuery{PageSize: 2, Filter: port.PendingGameFilter{ExcludeCreatorID: "alice"}}))
+	assert.Equal(t, []string{created[4], created[2]},
+		collect(port.PendingGameQuery{Order: port.OrderNewestFirst, Filter: port.PendingGameFilter{CreatorID: "alice", BoardSize: 3, CreatedAfter: start}}))
+}
+
+func TestGameService_PrivateGames(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	private, err := service.StartPrivateGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3}, "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, private.JoinCode)
+
+	// Hidden from search and matchmaking
+	page, err := service.SearchPendingGames(ctx, port.PendingGameQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, page.Games)
+
+	public, err := service.StartGame(ctx, "player2", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+	assert.NotEqual(t, private.ID, public.ID)
+	assert.Equal(t, entity.StatusPending, public.Status)
+
+	// Joining requires the code, which is matched leniently
+	_, err = service.JoinGame(ctx, "player3", private.ID)
+	assert.ErrorIs(t, err, entity.ErrNotInvited)
+
+	_, err = service.JoinGameByCode(ctx, "player3", "nope")
+	assert.ErrorIs(t, err, entity.ErrGameNotFound)
+
+	joined, err := service.JoinGameByCode(ctx, "player3", " "+strings.ToLower(private.JoinCode)+" ")
+	require.NoError(t, err)
+	assert.Equal(t, private.ID, joined.ID)
+	assert.Equal(t, entity.StatusInProgress, joined.Status)
+
+	// Invited users may join by game ID
+	invite, err := service.StartPrivateGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3}, "player4")
+	require.NoError(t, err)
+	joined, err = service.JoinGame(ctx, "player4", invite.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "player4", joined.Player2ID)
+}
+
+func TestGameService_Spectators(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	broker := pubsub.NewBroker()
+	service := NewGameService(gameRepo, userRepo, cfg, WithSpectatorTracker(broker))
+	ctx := context.Background()
+
+	quiet, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	_, _ = service.JoinGame(ctx, "player2", quiet.ID)
+	popular, _ := service.StartGame(ctx, "player3", entity.GameSettings{BoardSize: 4, WinningLength: 4})
+	_, _ = service.JoinGame(ctx, "player4", popular.ID)
+	_, _ = service.StartGame(ctx, "player5", entity.GameSettings{BoardSize: 5, WinningLength: 5}) // pending, not live
+
+	// Players are not counted as spectators
+	_, err := service.SpectateGame(ctx, popular.ID, "player3")
+	require.NoError(t, err)
+	assert.Zero(t, broker.SpectatorCount(popular.ID))
+
+	// Watching does not save the game
+	game, err := service.SpectateGame(ctx, popular.ID, "viewer")
+	require.NoError(t, err)
+	assert.Equal(t, 1, broker.SpectatorCount(popular.ID))
+	assert.Equal(t, popular.Version+1, game.Version) // only the join saved it
+
+	page, err := service.ListLiveGames(ctx, "", 0)
+	require.NoError(t, err)
+	require.Len(t, page.Games, 2)
+	assert.Equal(t, popular.ID, page.Games[0].ID)
+	assert.Equal(t, quiet.ID, page.Games[1].ID)
+
+	require.NoError(t, service.StopSpectating(ctx, popular.ID, "viewer"))
+	require.NoError(t, service.StopSpectating(ctx, popular.ID, "viewer"))
+	assert.Zero(t, broker.SpectatorCount(popular.ID))
+
+	// Closing a game to spectators drops them and hides it from discovery
+	_, err = service.SpectateGame(ctx, quiet.ID, "viewer")
+	require.NoError(t, err)
+	_, err = service.SetSpectatorsAllowed(ctx, "player2", quiet.ID, false)
+	assert.ErrorIs(t, err, entity.ErrNotGameCreator)
+	_, err = service.SetSpectatorsAllowed(ctx, "player1", quiet.ID, false)
+	require.NoError(t, err)
+	assert.Zero(t, broker.SpectatorCount(quiet.ID))
+
+	_, err = service.SpectateGame(ctx, quiet.ID, "viewer")
+	assert.ErrorIs(t, err, entity.ErrSpectatingClosed)
+
+	page, err = service.ListLiveGames(ctx, "", 0)
+	require.NoError(t, err)
+	require.Len(t, page.Games, 1)
+	assert.Equal(t, popular.ID, page.Games[0].ID)
+}
+
+// interleavingGameRepository runs interleave once, right after a game is
+// loaded, as if another request had been handled in the meantime.
+type interleavingGameRepository struct {
+	port.GameRepository
+	interleave func()
+}
+
+func (r *interleavingGameRepository) FindByID(ctx context.Context, id string) (*entity.Game, error) {
+	game, err := r.GameRepository.FindByID(ctx, id)
+	if interleave := r.interleave; interleave != nil {
+		r.interleave = nil
+		interleave()
+	}
+	return game, err
+}
+
+func TestGameService_ConcurrentUpdates(t *testing.T) {
+	gameRepo := &interleavingGameRepository{GameRepository: repository.NewInMemoryGameRepository()}
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	game, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	_, _ = service.JoinGame(ctx, "player2", game.ID)
+
+	// A stale copy can no longer be saved over a newer game
+	stale, err := gameRepo.FindByID(ctx, game.ID)
+	require.NoError(t, err)
+	_, err = service.MakeMove(ctx, "player1", game.ID, 0, 0)
+	require.NoError(t, err)
+	assert.ErrorIs(t, gameRepo.Save(ctx, stale), port.ErrConcurrentUpdate)
+
+	// Closing the game to spectators while a move is made keeps the move
+	gameRepo.interleave = func() {
+		_, err := service.MakeMove(ctx, "player2", game.ID, 1, 1)
+		require.NoError(t, err)
+	}
+	game, err = service.SetSpectatorsAllowed(ctx, "player1", game.ID, false)
+	require.NoError(t, err)
+	assert.False(t, game.SpectatorsAllowed)
+	assert.Len(t, game.Moves, 2)
+
+	// And a move made while the game is reopened keeps it open
+	gameRepo.interleave = func() {
+		_, err := service.SetSpectatorsAllowed(ctx, "player1", game.ID, true)
+		require.NoError(t, err)
+	}
+	game, err = service.MakeMove(ctx, "player1", game.ID, 2, 2)
+	require.NoError(t, err)
+	assert.True(t, game.SpectatorsAllowed)
+	assert.Len(t, game.Moves, 3)
+}
+
+// failingGameRepository fails to save the games fail picks.
+type failingGameRepository struct {
+	port.GameRepository
+	fail func(*entity.Game) bool
+}
+
+var errStorageUnavailable = errors.New("storage unavailable")
+
+func (r *failingGameRepository) Save(ctx context.Context, game *entity.Game) error {
+	if r.fail != nil && r.fail(game) {
+		return errStorageUnavailable
+	}
+	return r.GameRepository.Save(ctx, game)
+}
+
+func TestGameService_Rematch(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	cfg.RematchOfferTTL = 50 * time.Millisecond
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	playToWin := func() *entity.Game {
+		game, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+		_, _ = service.JoinGame(ctx, "player2", game.ID)
+		moves := []struct {
+			player   string
+			row, col int
+		}{{"player1", 0, 0}, {"player2", 1, 0}, {"player1", 0, 1}, {"player2", 1, 1}, {"player1", 0, 2}}
+		for _, m := range moves {
+			_, err := service.MakeMove(ctx, m.player, game.ID, m.row, m.col)
+			require.NoError(t, err)
+		}
+		return game
+	}
+
+	// Accepting starts a new game with colors swapped
+	game := playToWin()
+	_, err := service.AcceptRematch(ctx, "player2", game.ID)
+	assert.ErrorIs(t, err, entity.ErrNoRematchOffer)
+
+	offered, err := service.OfferRematch(ctx, "player1", game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RematchOffered, offered.Rematch.Status)
+
+	rematch, err := service.AcceptRematch(ctx, "player2", game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "player2", rematch.Player1ID)
+	assert.Equal(t, "player1", rematch.Player2ID)
+	assert.Equal(t, entity.StatusInProgress, rematch.Status)
+
+	saved, err := service.GetGame(ctx, rematch.ID, "player1")
+	require.NoError(t, err)
+	assert.Equal(t, rematch.ID, saved.ID)
+	old, err := service.GetGame(ctx, game.ID, "player1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.RematchAccepted, old.Rematch.Status)
+	assert.Equal(t, rematch.ID, old.Rematch.GameID)
+
+	// Declining leaves no new game
+	game = playToWin()
+	_, err = service.OfferRematch(ctx, "player2", game.ID)
+	require.NoError(t, err)
+	declined, err := service.DeclineRematch(ctx, "player1", game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RematchDeclined, declined.Rematch.Status)
+
+	// Unanswered offers expire
+	game = playToWin()
+	_, err = service.OfferRematch(ctx, "player1", game.ID)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		expired, err := service.GetGame(ctx, game.ID, "player1")
+		return err == nil && expired.Rematch.Status == entity.RematchExpired
+	}, 2*time.Second, 10*time.Millisecond)
+	_, err = service.AcceptRematch(ctx, "player2", game.ID)
+	assert.ErrorIs(t, err, entity.ErrNoRematchOffer)
+}
+
+func TestGameService_ExpireRematch_AnsweredMeanwhile(t *testing.T) {
+	gameRepo := &interleavingGameRepository{GameRepository: repository.NewInMemoryGameRepository()}
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg).(*gameService)
+	ctx := context.Background()
+
+	game := entity.NewGame("player1", 3, 3)
+	require.NoError(t, game.JoinPlayer("player2"))
+	require.NoError(t, game.ForceEnd("player1", false))
+	game.Rematch = entity.Rematch{Status: entity.RematchOffered, OfferedBy: "player1", ExpiresAt: time.Now()}
+	require.NoError(t, gameRepo.Save(ctx, game))
+
+	// The offer is accepted after the timer has loaded the game, but before
+	// it saves the expiry
+	gameRepo.interleave = func() {
+		accepted, err := gameRepo.FindByID(ctx, game.ID)
+		require.NoError(t, err)
+		accepted.Rematch.Status = entity.RematchAccepted
+		accepted.Rematch.GameID = "rematch"
+		require.NoError(t, gameRepo.Save(ctx, accepted))
+	}
+	service.expireRematch(ctx, game.ID)
+
+	saved, err := gameRepo.FindByID(ctx, game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RematchAccepted, saved.Rematch.Status)
+	assert.Equal(t, "rematch", saved.Rematch.GameID)
+}
+
+func TestGameService_AcceptRematch_SaveFails(t *testing.T) {
+	gameRepo := &failingGameRepository{GameRepository: repository.NewInMemoryGameRepository()}
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	game := entity.NewGame("player1", 3, 3)
+	require.NoError(t, game.JoinPlayer("player2"))
+	require.NoError(t, game.ForceEnd("player1", false))
+	require.NoError(t, gameRepo.Save(ctx, game))
+	_, err := service.OfferRematch(ctx, "player1", game.ID)
+	require.NoError(t, err)
+
+	// The rematch cannot be saved, so the offer is put back
+	gameRepo.fail = func(g *entity.Game) bool { return g.ID != game.ID }
+	_, err = service.AcceptRematch(ctx, "player2", game.ID)
+	assert.ErrorIs(t, err, errStorageUnavailable)
+
+	saved, err := gameRepo.FindByID(ctx, game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RematchOffered, saved.Rematch.Status)
+	assert.Empty(t, saved.Rematch.GameID)
+
+	// And can still be accepted
+	gameRepo.fail = nil
+	rematch, err := service.AcceptRematch(ctx, "player2", game.ID)
+	require.NoError(t, err)
+	saved, err = gameRepo.FindByID(ctx, game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, rematch.ID, saved.Rematch.GameID)
+}
+
+func TestGameService_Draws(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	game, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 20, WinningLength: 5})
+	_, _ = service.JoinGame(ctx, "player2", game.ID)
+
+	offered, err := service.OfferDraw(ctx, "player1", game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "player1", offered.DrawOfferedBy)
+
+	_, err = service.DeclineDraw(ctx, "player1", game.ID)
+	assert.ErrorIs(t, err, entity.ErrNoDrawOffer)
+	declined, err := service.DeclineDraw(ctx, "player2", game.ID)
+	require.NoError(t, err)
+	assert.Empty(t, declined.DrawOfferedBy)
+
+	_, err = service.OfferDraw(ctx, "player2", game.ID)
+	require.NoError(t, err)
+	drawn, err := service.AcceptDraw(ctx, "player1", game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StatusFinishedDraw, drawn.Status)
+
+	// An agreed draw counts like any other
+	for _, userID := range []string{"player1", "player2"} {
+		stats, err := service.GetUserStats(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats.Draws)
+		assert.Equal(t, 1, stats.TotalGames)
+	}
+}
+
+func TestGameService_Draws_ConcurrentMove(t *testing.T) {
+	gameRepo := &interleavingGameRepository{GameRepository: repository.NewInMemoryGameRepository()}
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	game, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 20, WinningLength: 5})
+	_, _ = service.JoinGame(ctx, "player2", game.ID)
+
+	// A move landing while the offer is made is kept
+	gameRepo.interleave = func() {
+		_, err := service.MakeMove(ctx, "player1", game.ID, 0, 0)
+		require.NoError(t, err)
+	}
+	offered, err := service.OfferDraw(ctx, "player1", game.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "player1", offered.DrawOfferedBy)
+	assert.Len(t, offered.Moves, 1)
+
+	// And declining keeps a spectator policy changed meanwhile
+	gameRepo.interleave = func() {
+		_, err := service.SetSpectatorsAllowed(ctx, "player1", game.ID, false)
+		require.NoError(t, err)
+	}
+	declined, err := service.DeclineDraw(ctx, "player2", game.ID)
+	require.NoError(t, err)
+	assert.Empty(t, declined.DrawOfferedBy)
+	assert.False(t, declined.SpectatorsAllowed)
+}
+
+func TestGameService_Takebacks(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	cfg.MaxTakebacks = 1
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	ranked, _ := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	_, _ = service.JoinGame(ctx, "player2", ranked.ID)
+	_, _ = service.MakeMove(ctx, "player1", ranked.ID, 0, 0)
+	_, err := service.RequestTakeback(ctx, "player1", ranked.ID)
+	assert.ErrorIs(t, err, entity.ErrTakebacksNotAllowed)
+
+	casual, _ := service.StartPrivateGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3}, "player2")
+	_, _ = service.JoinGame(ctx, "player2", casual.ID)
+	_, _ = service.MakeMove(ctx, "player1", casual.ID, 0, 0)
+
+	requested, err := service.RequestTakeback(ctx, "player1", casual.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "player1", requested.TakebackRequestedBy)
+
+	game, err := service.RespondTakeback(ctx, "player2", casual.ID, true)
+	require.NoError(t, err)
+	assert.Empty(t, game.Moves)
+	assert.Len(t, game.Takebacks, 1)
+	assert.True(t, game.IsTurnOf("player1"))
+
+	// Casual public games are only matched with each other
+	casualSetting := false
+	openCasual, err := service.StartGame(ctx, "player3", entity.GameSettings{BoardSize: 3, WinningLength: 3, Ranked: &casualSetting})
+	require.NoError(t, err)
+	assert.False(t, openCasual.Ranked)
+	matched, err := service.StartGame(ctx, "player4", entity.GameSettings{BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+	assert.NotEqual(t, openCasual.ID, matched.ID)
+	matched, err = service.StartGame(ctx, "player5", entity.GameSettings{BoardSize: 3, WinningLength: 3, Ranked: &casualSetting})
+	require.NoError(t, err)
+	assert.Equal(t, openCasual.ID, matched.ID)
+
+	// The configured limit applies per game, to both players
+	_, _ = service.MakeMove(ctx, "player1", casual.ID, 1, 1)
+	_, err = service.RequestTakeback(ctx, "player1", casual.ID)
+	assert.ErrorIs(t, err, entity.ErrTakebackLimit)
+	_, _ = service.MakeMove(ctx, "player2", casual.ID, 2, 2)
+	_, err = service.RequestTakeback(ctx, "player2", casual.ID)
+	assert.ErrorIs(t, err, entity.ErrTakebackLimit)
+}
+
+func TestGameService_Variants(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	_, err := service.StartGame(ctx, "player1", entity.GameSettings{Variant: "nope"})
+	assert.ErrorIs(t, err, entity.ErrUnknownVariant)
+	_, err = service.StartPrivateGame(ctx, "player1", entity.GameSettings{Variant: "nope"}, "")
+	assert.ErrorIs(t, err, entity.ErrUnknownVariant)
+
+	standard, err := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3})
+	require.NoError(t, err)
+	assert.Equal(t, entity.VariantStandard, standard.Variant)
+
+	// Players are only matched into games of the same variant
+	other, err := service.StartGame(ctx, "player2", entity.GameSettings{BoardSize: 3, Variant: "mirror"})
+	require.NoError(t, err)
+	assert.Equal(t, entity.StatusPending, other.Status)
+	assert.Equal(t, "mirror", other.Variant)
+
+	page, err := service.SearchPendingGames(ctx, port.PendingGameQuery{Filter: port.PendingGameFilter{Variant: "mirror"}})
+	require.NoError(t, err)
+	require.Len(t, page.Games, 1)
+	assert.Equal(t, other.ID, page.Games[0].ID)
+
+	joined, err := service.StartGame(ctx, "player3", entity.GameSettings{BoardSize: 3, Variant: "mirror"})
+	require.NoError(t, err)
+	assert.Equal(t, other.ID, joined.ID)
+}
+
+func TestGameService_BoardDimensions(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	cfg.MaxBoardSize = 12
+	cfg.MaxBoardCells = 50
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	_, err := service.StartGame(ctx, "player1", entity.GameSettings{BoardWidth: 10, BoardHeight: 6})
+	assert.ErrorIs(t, err, config.ErrBoardTooLarge)
+
+	wide, err := service.StartGame(ctx, "player1", entity.GameSettings{BoardWidth: 8, BoardHeight: 6, WinningLength: 4})
+	require.NoError(t, err)
+	assert.Equal(t, 8, wide.BoardWidth)
+	assert.Equal(t, 6, wide.BoardHeight)
+
+	// board_size alone still makes a square board
+	square, err := service.StartGame(ctx, "player2", entity.GameSettings{BoardSize: 5, WinningLength: 4})
+	require.NoError(t, err)
+	assert.Equal(t, 5, square.BoardWidth)
+	assert.Equal(t, 5, square.BoardHeight)
+
+	// Each dimension is limited on its own
+	narrow, err := service.StartGame(ctx, "player3", entity.GameSettings{BoardWidth: 30, BoardHeight: 1})
+	require.NoError(t, err)
+	assert.Equal(t, cfg.MaxBoardSize, narrow.BoardWidth)
+	assert.Equal(t, cfg.DefaultBoardSize, narrow.BoardHeight)
+
+	search := func(filter port.PendingGameFilter) []string {
+		page, err := service.SearchPendingGames(ctx, port.PendingGameQuery{Filter: filter})
+		require.NoError(t, err)
+		var ids []string
+		for _, game := range page.Games {
+			ids = append(ids, game.ID)
+		}
+		return ids
+	}
+	assert.Equal(t, []string{wide.ID}, search(port.PendingGameFilter{BoardWidth: 8}))
+	assert.Equal(t, []string{wide.ID}, search(port.PendingGameFilter{BoardWidth: 8, BoardHeight: 6}))
+	assert.Empty(t, search(port.PendingGameFilter{BoardSize: 6}))
+	assert.Equal(t, []string{square.ID}, search(port.PendingGameFilter{BoardSize: 5}))
+
+	// Matchmaking needs both dimensions to agree
+	tall, err := service.StartGame(ctx, "player4", entity.GameSettings{BoardWidth: 6, BoardHeight: 8, WinningLength: 4})
+	require.NoError(t, err)
+	assert.Equal(t, entity.StatusPending, tall.Status)
+	joined, err := service.StartGame(ctx, "player4", entity.GameSettings{BoardWidth: 8, BoardHeight: 6, WinningLength: 4})
+	require.NoError(t, err)
+	assert.Equal(t, wide.ID, joined.ID)
+}
+
+func TestGameService_MisereStats(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	play := func(variant string) *entity.Game {
+		game, err := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, WinningLength: 3, Variant: variant})
+		require.NoError(t, err)
+		game, err = service.JoinGame(ctx, "player2", game.ID)
+		require.NoError(t, err)
+		service.MakeMove(ctx, "player1", game.ID, 0, 0)           // X
+		service.MakeMove(ctx, "player2", game.ID, 1, 0)           // O
+		service.MakeMove(ctx, "player1", game.ID, 0, 1)           // X
+		service.MakeMove(ctx, "player2", game.ID, 1, 2)           // O
+		game, _ = service.MakeMove(ctx, "player1", game.ID, 0, 2) // X completes the top row
+		return game
+	}
+
+	assert.Equal(t, "player1", play(entity.VariantStandard).WinnerID)
+	assert.Equal(t, "player2", play(entity.VariantMisere).WinnerID)
+
+	stats, err := service.GetUserStats(ctx, "player1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Wins)
+	assert.Equal(t, 1, stats.Losses)
+	assert.Equal(t, 2, stats.TotalGames)
+	assert.Equal(t, map[string]entity.VariantStats{
+		entity.VariantStandard: {Wins: 1, TotalGames: 1},
+		entity.VariantMisere:   {Losses: 1, TotalGames: 1},
+	}, stats.Variants)
+}
+
+func TestGameService_MultiPlayerGame(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	_, err := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 4, WinningLength: 3, Seats: entity.MaxSeats + 1})
+	assert.ErrorIs(t, err, entity.ErrInvalidSeats)
+
+	settings := entity.GameSettings{BoardSize: 4, WinningLength: 3, Seats: 3}
+	game, err := service.StartGame(ctx, "player1", settings)
+	require.NoError(t, err)
+
+	// Matchmaking only pairs players asking for the same number of seats
+	twoSeats, err := service.StartGame(ctx, "player2", entity.GameSettings{BoardSize: 4, WinningLength: 3})
+	require.NoError(t, err)
+	assert.NotEqual(t, game.ID, twoSeats.ID)
+
+	joined, err := service.StartGame(ctx, "player2", settings)
+	require.NoError(t, err)
+	assert.Equal(t, game.ID, joined.ID)
+	assert.Equal(t, entity.StatusPending, joined.Status)
+	joined, err = service.StartGame(ctx, "player3", settings)
+	require.NoError(t, err)
+	assert.Equal(t, game.ID, joined.ID)
+	assert.Equal(t, entity.StatusInProgress, joined.Status)
+
+	moves := [][2]int{{0, 0}, {1, 0}, {2, 0}, {0, 1}, {1, 1}, {2, 1}, {0, 2}}
+	for i, move := range moves {
+		game, err = service.MakeMove(ctx, joined.Players[i%3], game.ID, move[0], move[1])
+		require.NoError(t, err)
+	}
+	assert.Equal(t, "player1", game.WinnerID)
+
+	// The winner gets a win and everyone else a loss
+	for playerID, want := range map[string][2]int{"player1": {1, 0}, "player2": {0, 1}, "player3": {0, 1}} {
+		stats, err := service.GetUserStats(ctx, playerID)
+		require.NoError(t, err)
+		assert.Equal(t, want, [2]int{stats.Wins, stats.Losses}, playerID)
+	}
+
+	page, err := service.ListUserGames(ctx, "player3", nil, "", 0)
+	require.NoError(t, err)
+	assert.Len(t, page.Games, 1)
+}
+
+func TestGameService_CustomSetup(t *testing.T) {
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	cfg := config.DefaultConfig()
+	service := NewGameService(gameRepo, userRepo, cfg)
+	ctx := context.Background()
+
+	_, err := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, Obstacles: []entity.Position{{Row: 3, Col: 3}}})
+	assert.ErrorIs(t, err, entity.ErrInvalidPlacement)
+
+	blocked, err := service.StartGame(ctx, "player1", entity.GameSettings{BoardSize: 3, RandomObstacles: 2})
+	require.NoError(t, err)
+	assert.Len(t, blocked.Obstacles(), 2)
+
+	// Plain boards are not matched into games with obstacles, nor the other
+	// way round
+	plain, err := service.StartGame(ctx, "player2", entity.GameSettings{BoardSize: 3})
+	require.NoError(t, err)
+	assert.NotEqual(t, blocked.ID, plain.ID)
+	assert.Equal(t, entity.StatusPending, plain.Status)
+
+	again, err := service.StartGame(ctx, "player3", entity.GameSettings{BoardSize: 3, RandomObstacles: 2})
+	require.NoError(t, err)
+	assert.NotEqual(t, blocked.ID, again.ID)
+
+	joined, err := service.JoinGame(ctx, "player2", blocked.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StatusInProgress, joined.Status)
+
+	// Gravity and ultimate games cannot have obstacles or handicap stones
+	_, err = service.StartGame(ctx, "player1", entity.GameSettings{BoardWidth: 7, BoardHeight: 6, Variant: entity.VariantGravity, RandomObstacles: 3})
+	assert.ErrorIs(t, err, entity.ErrInvalidPlacement)
+	_, err = service.StartGame(ctx, "player1", entity.GameSettings{BoardWidth: 7, BoardHeight: 6, Variant: entity.VariantGravity,
+		HandicapStones: []entity.Position{{Row: 5, Col: 0}}})
+	assert.ErrorIs(t, err, entity.ErrInvalidPlacement)
+	_, err = service.StartPrivateGame(ctx, "player1", entity.GameSettings{Variant: entity.VariantUltimate,
+		Obstacles: []entity.Position{{Row: 4, Col: 4}}}, "")
+	assert.ErrorIs(t, err, entity.ErrInvalidPlacement)
+}
+
+func init() {
+	// mirror plays like the standard game under another name
+	entity.RegisterVariant("mirror", entity.StandardRules{})
+}
@@ -0,0 +1,70 @@
+// internal/application/service/pagination.go
+package service
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// paginate returns the page of games selected by an offset-based page token.
+// Tokens are opaque to clients; a token past the end yields an empty page.
+func paginate(games []*entity.Game, pageToken string, pageSize int) (*port.GamePage, error) {
+	offset, pageSize, err := pageBounds(pageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if offset >= len(games) {
+		return newPage(nil, offset, len(games)), nil
+	}
+	return newPage(games[offset:min(offset+pageSize, len(games))], offset, len(games)), nil
+}
+
+// pageBounds decodes a page token into the offset of the page and clamps
+// the requested page size.
+func pageBounds(pageToken string, pageSize int) (offset, size int, err error) {
+	offset, err = decodePageToken(pageToken)
+	if err != nil {
+		return 0, 0, err
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	return offset, min(pageSize, maxPageSize), nil
+}
+
+// newPage wraps the games found at offset out of total matches, linking to
+// the next page if there is one.
+func newPage(games []*entity.Game, offset, total int) *port.GamePage {
+	page := &port.GamePage{Games: games, TotalSize: total}
+	if end := offset + len(games); len(games) > 0 && end < total {
+		page.NextPageToken = encodePageToken(end)
+	}
+	return page
+}
+
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, port.ErrInvalidPageToken
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, port.ErrInvalidPageToken
+	}
+	return offset, nil
+}
@@ -0,0 +1,45 @@
+// internal/application/service/update.go
+package service
+
+import (
+	"context"
+	"errors"
+
+	"tictactoe/internal/domain/entity"
+	"tictactoe/internal/domain/port"
+)
+
+// maxUpdateAttempts bounds the retries when a game keeps being saved by
+// other requests while updateGame changes it.
+const maxUpdateAttempts = 5
+
+// errUnchanged tells updateGame that update left the game as it was, so
+// there is nothing to save.
+var errUnchanged = errors.New("game unchanged")
+
+// updateGame loads a game, applies update and saves it. If another request
+// saved the game in between, it starts again from the stored game, so no
+// change is lost. When update returns errUnchanged, updateGame returns the
+// game as loaded along with errUnchanged.
+func updateGame(ctx context.Context, repo port.GameRepository, gameID string, update func(*entity.Game) error) (*entity.Game, error) {
+	for attempt := 1; ; attempt++ {
+		game, err := repo.FindByID(ctx, gameID)
+		if err != nil {
+			return nil, err
+		}
+		if err := update(game); errors.Is(err, errUnchanged) {
+			return game, err
+		} else if err != nil {
+			return nil, err
+		}
+
+		err = repo.Save(ctx, game)
+		if errors.Is(err, port.ErrConcurrentUpdate) && attempt < maxUpdateAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return game, nil
+	}
+}
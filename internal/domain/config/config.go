@@ -1,11 +1,26 @@
 // internal/domain/config/config.go
 package config
 
+import (
+	"errors"
+	"time"
+)
+
+var ErrBoardTooLarge = errors.New("board has too many cells")
+
 type Config struct {
 	DefaultBoardSize     int
 	DefaultWinningLength int
-	MaxBoardSize         int
-	MinBoardSize         int
+	// MaxBoardSize and MinBoardSize limit each dimension of the board.
+	MaxBoardSize int
+	MinBoardSize int
+	// MaxBoardCells limits the width times the height of the board.
+	MaxBoardCells int
+	// RematchOfferTTL is how long a rematch offer stays open.
+	RematchOfferTTL time.Duration
+	// MaxTakebacks is how many takebacks may be granted per game, counting
+	// both players' requests.
+	MaxTakebacks int
 }
 
 func DefaultConfig() *Config {
@@ -14,6 +29,9 @@ func DefaultConfig() *Config {
 		DefaultWinningLength: 3,
 		MaxBoardSize:         20, // Reasonable limit for scalability
 		MinBoardSize:         3,
+		MaxBoardCells:        400,
+		RematchOfferTTL:      time.Minute,
+		MaxTakebacks:         3,
 	}
 }
 
@@ -27,12 +45,21 @@ func (c *Config) ValidateBoardSize(size int) int {
 	return size
 }
 
-func (c *Config) ValidateWinningLength(length, boardSize int) int {
-	if length <= 0 {
-		return boardSize // Default to board size
+// ValidateBoardDimensions applies ValidateBoardSize to each dimension and
+// rejects boards with more than MaxBoardCells cells.
+func (c *Config) ValidateBoardDimensions(width, height int) (int, int, error) {
+	width, height = c.ValidateBoardSize(width), c.ValidateBoardSize(height)
+	if c.MaxBoardCells > 0 && width*height > c.MaxBoardCells {
+		return 0, 0, ErrBoardTooLarge
 	}
-	if length > boardSize {
-		return boardSize
+	return width, height, nil
+}
+
+// ValidateWinningLength defaults length to the shorter side of the board
+// and limits it to the longer side, so that a line always fits.
+func (c *Config) ValidateWinningLength(length, width, height int) int {
+	if length <= 0 || length > max(width, height) {
+		return min(width, height)
 	}
 	return length
 }
@@ -0,0 +1,70 @@
+// internal/domain/entity/draw.go
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrDrawOffered = errors.New("draw already offered")
+	ErrNoDrawOffer = errors.New("no draw offer to answer")
+)
+
+// OfferDraw offers the opponent a draw. The offer stands until it is
+// answered or the opponent moves instead. Only two-player games allow draw
+// offers.
+func (g *Game) OfferDraw(playerID string) error {
+	if !g.IsPlayerInGame(playerID) {
+		return ErrPlayerNotInGame
+	}
+	if g.Seats > 2 {
+		return ErrTwoPlayerOnly
+	}
+	if g.Status != StatusInProgress {
+		if g.IsFinished() {
+			return ErrGameFinished
+		}
+		return ErrGameNotStarted
+	}
+	if g.DrawOfferedBy != "" {
+		return ErrDrawOffered
+	}
+
+	g.DrawOfferedBy = playerID
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+// AcceptDraw accepts the opponent's open offer, finishing the game as a
+// draw.
+func (g *Game) AcceptDraw(playerID string) error {
+	if err := g.drawAnswerable(playerID); err != nil {
+		return err
+	}
+
+	g.setToDraw()
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+// DeclineDraw turns down the opponent's open offer.
+func (g *Game) DeclineDraw(playerID string) error {
+	if err := g.drawAnswerable(playerID); err != nil {
+		return err
+	}
+
+	g.DrawOfferedBy = ""
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+func (g *Game) drawAnswerable(playerID string) error {
+	if !g.IsPlayerInGame(playerID) {
+		return ErrPlayerNotInGame
+	}
+	if g.Status != StatusInProgress || g.DrawOfferedBy == "" || g.DrawOfferedBy == playerID {
+		return ErrNoDrawOffer
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// internal/domain/entity/draw_test.go
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGame_DrawOffers(t *testing.T) {
+	pending := NewGame("player1", 3, 3)
+	assert.Equal(t, ErrGameNotStarted, pending.OfferDraw("player1"))
+
+	game := NewGame("player1", 3, 3)
+	require.NoError(t, game.JoinPlayer("player2"))
+	assert.Equal(t, ErrPlayerNotInGame, game.OfferDraw("player3"))
+	assert.Equal(t, ErrNoDrawOffer, game.AcceptDraw("player2"))
+
+	require.NoError(t, game.OfferDraw("player1"))
+	assert.Equal(t, "player1", game.DrawOfferedBy)
+	assert.Equal(t, ErrDrawOffered, game.OfferDraw("player2"))
+	assert.Equal(t, ErrNoDrawOffer, game.AcceptDraw("player1"))
+
+	// The offerer moving keeps the offer open; the opponent moving declines it
+	require.NoError(t, game.MakeMove("player1", Position{0, 0}))
+	assert.Equal(t, "player1", game.DrawOfferedBy)
+	require.NoError(t, game.MakeMove("player2", Position{1, 1}))
+	assert.Empty(t, game.DrawOfferedBy)
+
+	require.NoError(t, game.OfferDraw("player2"))
+	require.NoError(t, game.DeclineDraw("player1"))
+	assert.Empty(t, game.DrawOfferedBy)
+	assert.Equal(t, StatusInProgress, game.Status)
+
+	require.NoError(t, game.OfferDraw("player2"))
+	require.NoError(t, game.AcceptDraw("player1"))
+	assert.Equal(t, StatusFinishedDraw, game.Status)
+	assert.Empty(t, game.WinnerID)
+	assert.Equal(t, ErrGameFinished, game.OfferDraw("player1"))
+}
+
+func TestGame_DeadPosition(t *testing.T) {
+	game := NewGame("player1", 3, 3)
+	game.Board = [][]string{
+		{"X", "O", "X"},
+		{"X", "O", "O"},
+		{"O", "", "X"},
+	}
+	// O could complete the middle column, but only if it were O's move
+	assert.True(t, isDeadPosition(game, "X"))
+	assert.False(t, isDeadPosition(game, "O"))
+
+	open := NewGame("player1", 20, 5)
+	assert.False(t, isDeadPosition(open, "X"))
+
+	// Alternating stripes block every row, column and diagonal on a large
+	// board long before it is full
+	blocked := NewGame("player1", 6, 4)
+	for r := range blocked.Board {
+		for c := range blocked.Board[r] {
+			if (r/2+c)%2 == 0 {
+				blocked.Board[r][c] = "X"
+			} else {
+				blocked.Board[r][c] = "O"
+			}
+		}
+	}
+	blocked.Board[0][0] = ""
+	assert.True(t, isDeadPosition(blocked, "X"))
+}
+
+func TestGame_EarlyDraw(t *testing.T) {
+	game := NewGame("player1", 3, 3)
+	require.NoError(t, game.JoinPlayer("player2"))
+
+	moves := []Position{{0, 0}, {0, 1}, {0, 2}, {1, 1}, {1, 0}, {1, 2}, {2, 1}, {2, 0}}
+	for i, move := range moves {
+		player := "player1"
+		if i%2 == 1 {
+			player = "player2"
+		}
+		require.NoError(t, game.MakeMove(player, move))
+	}
+
+	// One cell is still empty, but it completes no line
+	assert.Equal(t, StatusFinishedDraw, game.Status)
+	assert.Empty(t, game.Board[2][2])
+}
@@ -3,6 +3,8 @@ package entity
 
 import (
 	"errors"
+	"math/rand/v2"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,6 +18,13 @@ var (
 	ErrInvalidMove      = errors.New("invalid move")
 	ErrPositionOccupied = errors.New("position already occupied")
 	ErrPlayerNotInGame  = errors.New("player not in game")
+	ErrGameNotStarted   = errors.New("game has not started")
+	ErrNotInvited       = errors.New("player not invited to game")
+	ErrNotGameCreator   = errors.New("only the game creator may do this")
+	ErrSpectatingClosed = errors.New("game does not allow spectators")
+	ErrInvalidSeats     = errors.New("invalid number of seats")
+	ErrTwoPlayerOnly    = errors.New("only available in two-player games")
+	ErrInvalidPlayer    = errors.New("player ID is required")
 )
 
 type GameStatus int
@@ -28,113 +37,405 @@ const (
 	StatusAbandoned
 )
 
+func (s GameStatus) String() string {
+	switch s {
+	case StatusPending:
+		return "pending"
+	case StatusInProgress:
+		return "in_progress"
+	case StatusFinishedWin:
+		return "finished_win"
+	case StatusFinishedDraw:
+		return "finished_draw"
+	case StatusAbandoned:
+		return "abandoned"
+	default:
+		return "unknown"
+	}
+}
+
+// MaxSeats is the most players a game can seat.
+const MaxSeats = 4
+
+// playerSymbols are the symbols of each seat, in turn order.
+var playerSymbols = [MaxSeats]string{"X", "O", "Y", "Z"}
+
 type Position struct {
 	Row int
 	Col int
 }
 
-func (p Position) IsValid(boardSize int) bool {
-	return p.Row >= 0 && p.Row < boardSize && p.Col >= 0 && p.Col < boardSize
+// IsValid reports whether p is on a board of the given width and height.
+func (p Position) IsValid(width, height int) bool {
+	return p.Row >= 0 && p.Row < height && p.Col >= 0 && p.Col < width
 }
 
 type Game struct {
 	ID            string
 	Player1ID     string
 	Player2ID     string
-	Board         [][]string
-	BoardSize     int
+	Board         [][]string // BoardHeight rows of BoardWidth cells
+	BoardWidth    int
+	BoardHeight   int
 	WinningLength int
+	// Variant names the Rules the game is played by.
+	Variant       string
 	Status        GameStatus
 	CurrentPlayer string
 	WinnerID      string
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	// Private games are hidden from search and matchmaking and can only be
+	// joined by the invited user or with the join code.
+	Private       bool
+	JoinCode      string
+	InvitedUserID string
+	// SpectatorsAllowed lets non-players watch the game. Who is watching is
+	// not part of the game; see port.SpectatorTracker.
+	SpectatorsAllowed bool
+	Rematch           Rematch
+	// DrawOfferedBy is the player with an open draw offer, if any.
+	DrawOfferedBy string
+	// Ranked games count towards competitive play and allow no takebacks.
+	// Unless chosen at creation, public games are ranked and private games
+	// casual.
+	Ranked bool
+	// Moves is the game's move history, oldest first.
+	Moves               []Move
+	TakebackRequestedBy string
+	// Takebacks records every granted takeback, oldest first.
+	Takebacks []Takeback
+	// Players lists everyone seated, in turn order: Players[0] is Player1ID
+	// and Players[1] is Player2ID. The game starts once Seats are filled.
+	Players []string
+	Seats   int
+	// HandicapStones are the second player's stones placed before the
+	// first move.
+	HandicapStones []Position
+	// Version counts the saves of the game. Repositories use it to refuse
+	// saving a copy that is older than the stored game.
+	Version int
+}
+
+// Move is a single ply in the game's history.
+type Move struct {
+	PlayerID string
+	Position Position
+	PlayedAt time.Time
+}
+
+// joinCodeAlphabet leaves out characters that are easily confused when read
+// aloud or typed (0/O, 1/I/L).
+const joinCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+const joinCodeLength = 6
+
+// NewJoinCode returns a random, human-friendly join code.
+func NewJoinCode() string {
+	code := make([]byte, joinCodeLength)
+	for i := range code {
+		code[i] = joinCodeAlphabet[rand.IntN(len(joinCodeAlphabet))]
+	}
+	return string(code)
 }
 
+// GameSettings are chosen when a game is created and carried over to its
+// rematches.
+type GameSettings struct {
+	// BoardSize is the side of a square board, used for BoardWidth and
+	// BoardHeight when those are not set.
+	BoardSize     int
+	BoardWidth    int
+	BoardHeight   int
+	WinningLength int
+	// Variant names registered Rules; empty means VariantStandard.
+	Variant string
+	// Seats is the number of players, 2 to MaxSeats; 0 means 2.
+	Seats int
+	// Obstacles are blocked cells, and RandomObstacles more are blocked at
+	// random. HandicapStones are placed for the second seat, the weaker
+	// player, before the first player's opening move.
+	Obstacles       []Position
+	RandomObstacles int
+	HandicapStones  []Position
+	// Ranked chooses a ranked or a casual game; nil leaves public games
+	// ranked and private games casual.
+	Ranked *bool
+}
+
+// NewGame creates a standard game.
 func NewGame(player1ID string, boardSize, winningLength int) *Game {
-	if boardSize <= 0 {
-		boardSize = 3
+	return NewGameWithSettings(player1ID, GameSettings{BoardSize: boardSize, WinningLength: winningLength})
+}
+
+// NewGameWithSettings creates a game waiting for its other players. The
+// variant must be registered.
+func NewGameWithSettings(player1ID string, settings GameSettings) *Game {
+	variant := settings.Variant
+	if variant == "" {
+		variant = VariantStandard
 	}
-	if winningLength <= 0 || winningLength > boardSize {
-		winningLength = boardSize
+	if adjuster, ok := variants[variant].(SettingsAdjuster); ok {
+		settings = adjuster.AdjustSettings(settings)
 	}
 
-	board := make([][]string, boardSize)
+	width, height := settings.Dimensions()
+	if width <= 0 {
+		width = 3
+	}
+	if height <= 0 {
+		height = 3
+	}
+	winningLength := settings.WinningLength
+	if winningLength <= 0 || winningLength > max(width, height) {
+		winningLength = min(width, height)
+	}
+	seats := min(max(settings.Seats, 2), MaxSeats)
+
+	board := make([][]string, height)
 	for i := range board {
-		board[i] = make([]string, boardSize)
+		board[i] = make([]string, width)
 	}
 
-	return &Game{
-		ID:            uuid.New().String(),
-		Player1ID:     player1ID,
-		Board:         board,
-		BoardSize:     boardSize,
-		WinningLength: winningLength,
-		Status:        StatusPending,
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
+	game := &Game{
+		ID:                uuid.New().String(),
+		Player1ID:         player1ID,
+		Players:           []string{player1ID},
+		Seats:             seats,
+		Board:             board,
+		BoardWidth:        width,
+		BoardHeight:       height,
+		WinningLength:     winningLength,
+		Variant:           variant,
+		Status:            StatusPending,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+		SpectatorsAllowed: true,
+		Ranked:            settings.Ranked == nil || *settings.Ranked,
 	}
+	game.placeSetup(settings)
+	return game
 }
 
+// Dimensions returns the board width and height the settings ask for.
+func (s GameSettings) Dimensions() (width, height int) {
+	width, height = s.BoardWidth, s.BoardHeight
+	if width <= 0 {
+		width = s.BoardSize
+	}
+	if height <= 0 {
+		height = s.BoardSize
+	}
+	return width, height
+}
+
+// NewPrivateGame creates a game that is only open to invitedUserID, if set,
+// and to anyone presenting its join code.
+func NewPrivateGame(player1ID string, settings GameSettings, invitedUserID string) *Game {
+	game := NewGameWithSettings(player1ID, settings)
+	game.Private = true
+	game.JoinCode = NewJoinCode()
+	game.InvitedUserID = invitedUserID
+	game.SpectatorsAllowed = false
+	game.Ranked = settings.Ranked != nil && *settings.Ranked
+	return game
+}
+
+// Settings returns the settings the game was created with. Randomly
+// blocked cells are reported as Obstacles, so the same board is set up
+// again.
+func (g *Game) Settings() GameSettings {
+	ranked := g.Ranked
+	return GameSettings{
+		BoardWidth:     g.BoardWidth,
+		BoardHeight:    g.BoardHeight,
+		WinningLength:  g.WinningLength,
+		Variant:        g.Variant,
+		Seats:          g.Seats,
+		Obstacles:      g.Obstacles(),
+		HandicapStones: append([]Position(nil), g.HandicapStones...),
+		Ranked:         &ranked,
+	}
+}
+
+// Clone returns a deep copy of the game, safe to hand to other goroutines.
+func (g *Game) Clone() *Game {
+	gameCopy := *g
+	gameCopy.Board = make([][]string, len(g.Board))
+	for i, row := range g.Board {
+		gameCopy.Board[i] = make([]string, len(row))
+		copy(gameCopy.Board[i], row)
+	}
+	gameCopy.Players = append([]string(nil), g.Players...)
+	gameCopy.HandicapStones = append([]Position(nil), g.HandicapStones...)
+	gameCopy.Moves = append([]Move(nil), g.Moves...)
+	gameCopy.Takebacks = make([]Takeback, len(g.Takebacks))
+	for i, takeback := range g.Takebacks {
+		takeback.Moves = append([]Move(nil), takeback.Moves...)
+		gameCopy.Takebacks[i] = takeback
+	}
+	return &gameCopy
+}
+
+// JoinPlayer takes the next open seat. Private games only admit the invited
+// user; others must use JoinPlayerWithCode.
 func (g *Game) JoinPlayer(playerID string) error {
+	if playerID == "" {
+		return ErrInvalidPlayer
+	}
+	if g.Private && playerID != g.InvitedUserID {
+		return ErrNotInvited
+	}
+	return g.join(playerID)
+}
+
+// JoinPlayerWithCode takes the next open seat in a private game if code is
+// its join code.
+func (g *Game) JoinPlayerWithCode(playerID, code string) error {
+	if !g.Private || code != g.JoinCode {
+		return ErrNotInvited
+	}
+	return g.join(playerID)
+}
+
+func (g *Game) join(playerID string) error {
+	// An empty ID would also match a private game without an invitee
+	if playerID == "" {
+		return ErrInvalidPlayer
+	}
 	if g.Status != StatusPending {
 		return ErrGameFull
 	}
 
-	if g.Player1ID == playerID {
+	if g.IsPlayerInGame(playerID) {
 		return ErrGameFull // Same player cannot join twice
 	}
 
-	g.Player2ID = playerID
-	g.CurrentPlayer = g.Player1ID // Player 1 always starts
-	g.Status = StatusInProgress
+	g.Players = append(g.Players, playerID)
+	if len(g.Players) == 2 {
+		g.Player2ID = playerID
+	}
+	if g.OpenSeats() == 0 {
+		g.CurrentPlayer = g.Player1ID // Player 1 always starts
+		g.Status = StatusInProgress
+	}
 	g.UpdatedAt = time.Now()
 	return nil
 }
 
+// OpenSeats returns how many more players must join before the game starts.
+func (g *Game) OpenSeats() int {
+	return max(g.Seats-len(g.Players), 0)
+}
+
 func (g *Game) MakeMove(playerID string, pos Position) error {
-	err := g.validate(playerID, pos)
+	rules, err := g.Rules()
 	if err != nil {
 		return err
 	}
-
-	// Determine player symbol
-	symbol := g.GetPlayerSymbol(playerID)
+	if err := g.validate(rules, playerID, pos); err != nil {
+		return err
+	}
 
 	// Make the move
-	g.Board[pos.Row][pos.Col] = symbol
+	pos = rules.ApplyMove(g, playerID, pos)
 	g.UpdatedAt = time.Now()
+	g.Moves = append(g.Moves, Move{PlayerID: playerID, Position: pos, PlayedAt: g.UpdatedAt})
+
+	// Moving instead of answering declines the opponent's draw offer
+	if g.DrawOfferedBy != playerID {
+		g.DrawOfferedBy = ""
+	}
 
-	// Check for winner
-	if g.checkWinner(pos, symbol) {
-		g.setToWin(playerID)
-	} else if g.isBoardFull() {
+	outcome := rules.Outcome(g, playerID, pos)
+	switch {
+	case outcome.Finished && outcome.WinnerID != "":
+		g.setToWin(outcome.WinnerID)
+	case outcome.Finished:
+		g.setToDraw()
+	default:
+		g.CurrentPlayer = rules.NextPlayer(g, playerID)
+	}
+
+	return nil
+}
+
+// IsFinished reports whether the game has reached a terminal status.
+func (g *Game) IsFinished() bool {
+	switch g.Status {
+	case StatusFinishedWin, StatusFinishedDraw, StatusAbandoned:
+		return true
+	}
+	return false
+}
+
+// ForceEnd ends an unfinished game on an operator's behalf. With neither a
+// winner nor a draw the game is abandoned; declaring a result requires both
+// players to have joined.
+func (g *Game) ForceEnd(winnerID string, draw bool) error {
+	if g.IsFinished() {
+		return ErrGameFinished
+	}
+	if winnerID == "" && !draw {
+		g.Status = StatusAbandoned
+		g.closeRequests()
+		g.UpdatedAt = time.Now()
+		return nil
+	}
+	if g.Status != StatusInProgress {
+		return ErrGameNotStarted
+	}
+
+	if draw {
 		g.setToDraw()
 	} else {
-		g.switchTurn()
+		if !g.IsPlayerInGame(winnerID) {
+			return ErrPlayerNotInGame
+		}
+		g.setToWin(winnerID)
 	}
+	g.UpdatedAt = time.Now()
+	return nil
+}
 
+// SetSpectatorsAllowed lets the creator open or close the game to
+// spectators. Closing it ends the streams of everyone currently watching.
+func (g *Game) SetSpectatorsAllowed(playerID string, allowed bool) error {
+	if g.Player1ID != playerID {
+		if g.IsPlayerInGame(playerID) {
+			return ErrNotGameCreator
+		}
+		return ErrPlayerNotInGame
+	}
+	g.SpectatorsAllowed = allowed
 	return nil
 }
 
+// CanSpectate reports whether userID may watch the game. Players may always
+// watch their own game.
+func (g *Game) CanSpectate(userID string) bool {
+	return g.IsPlayerInGame(userID) || g.SpectatorsAllowed
+}
+
 func (g *Game) setToWin(playerID string) {
 	g.Status = StatusFinishedWin
 	g.WinnerID = playerID
+	g.closeRequests()
 }
 
 func (g *Game) setToDraw() {
 	g.Status = StatusFinishedDraw
+	g.closeRequests()
 }
 
-func (g *Game) switchTurn() {
-	if g.CurrentPlayer == g.Player1ID {
-		g.CurrentPlayer = g.Player2ID
-	} else {
-		g.CurrentPlayer = g.Player1ID
-	}
+// closeRequests drops offers that can no longer be answered once the game
+// is over.
+func (g *Game) closeRequests() {
+	g.DrawOfferedBy = ""
+	g.TakebackRequestedBy = ""
 }
 
-func (g *Game) validate(playerID string, pos Position) error {
+func (g *Game) validate(rules Rules, playerID string, pos Position) error {
 	if g.Status != StatusInProgress {
 		return ErrGameFinished
 	}
@@ -143,85 +444,43 @@ func (g *Game) validate(playerID string, pos Position) error {
 		return ErrNotPlayersTurn
 	}
 
-	if !pos.IsValid(g.BoardSize) {
-		return ErrInvalidMove
+	if pos.IsValid(g.BoardWidth, g.BoardHeight) && g.Board[pos.Row][pos.Col] == BlockedCell {
+		return ErrCellBlocked
 	}
 
-	if g.Board[pos.Row][pos.Col] != "" {
-		return ErrPositionOccupied
-	}
-	return nil
+	return rules.ValidateMove(g, playerID, pos)
 }
 
 func (g *Game) IsPlayerInGame(playerID string) bool {
-	return g.Player1ID == playerID || g.Player2ID == playerID
-}
-
-func (g *Game) GetPlayerSymbol(playerID string) string {
-	if playerID == g.Player1ID {
-		return "X"
-	}
-	if playerID == g.Player2ID {
-		return "O"
-	}
-	return ""
+	return playerID != "" && slices.Contains(g.Players, playerID)
 }
 
-func (g *Game) checkWinner(lastMove Position, symbol string) bool {
-	// Check all four directions: horizontal, vertical, diagonal, anti-diagonal
-	directions := [][2]int{
-		{0, 1},  // horizontal
-		{1, 0},  // vertical
-		{1, 1},  // diagonal
-		{1, -1}, // anti-diagonal
-	}
-
-	for _, dir := range directions {
-		if g.countInDirection(lastMove, symbol, dir[0], dir[1]) >= g.WinningLength {
-			return true
-		}
-	}
-	return false
+// IsTurnOf reports whether the game is waiting for playerID to move.
+func (g *Game) IsTurnOf(playerID string) bool {
+	return g.Status == StatusInProgress && g.CurrentPlayer == playerID
 }
 
-func (g *Game) countInDirection(pos Position, symbol string, deltaRow, deltaCol int) int {
-	count := 1 // Count the current position
-
-	// Count in positive direction
-	r, c := pos.Row+deltaRow, pos.Col+deltaCol
-	for r >= 0 && r < g.BoardSize && c >= 0 && c < g.BoardSize && g.Board[r][c] == symbol {
-		count++
-		r += deltaRow
-		c += deltaCol
-	}
-
-	// Count in negative direction
-	r, c = pos.Row-deltaRow, pos.Col-deltaCol
-	for r >= 0 && r < g.BoardSize && c >= 0 && c < g.BoardSize && g.Board[r][c] == symbol {
-		count++
-		r -= deltaRow
-		c -= deltaCol
+// GetPlayerSymbol returns the symbol of playerID's seat, or "" for
+// non-players.
+func (g *Game) GetPlayerSymbol(playerID string) string {
+	seat := slices.Index(g.Players, playerID)
+	if seat < 0 || seat >= MaxSeats {
+		return ""
 	}
-
-	return count
+	return playerSymbols[seat]
 }
 
-func (g *Game) isBoardFull() bool {
-	for i := 0; i < g.BoardSize; i++ {
-		for j := 0; j < g.BoardSize; j++ {
-			if g.Board[i][j] == "" {
-				return false
-			}
-		}
-	}
-	return true
+// IsSquare reports whether the board is as wide as it is tall.
+func (g *Game) IsSquare() bool {
+	return g.BoardWidth == g.BoardHeight
 }
 
+// FlattenBoard returns the board row by row.
 func (g *Game) FlattenBoard() []string {
-	flat := make([]string, g.BoardSize*g.BoardSize)
-	for i := 0; i < g.BoardSize; i++ {
-		for j := 0; j < g.BoardSize; j++ {
-			flat[i*g.BoardSize+j] = g.Board[i][j]
+	flat := make([]string, g.BoardWidth*g.BoardHeight)
+	for i := 0; i < g.BoardHeight; i++ {
+		for j := 0; j < g.BoardWidth; j++ {
+			flat[i*g.BoardWidth+j] = g.Board[i][j]
 		}
 	}
 	return flat
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewGame(t *testing.T) {
@@ -24,7 +25,8 @@ func TestNewGame(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			game := NewGame("player1", tt.boardSize, tt.winningLength)
 
-			assert.Equal(t, tt.expectedBS, game.BoardSize)
+			assert.Equal(t, tt.expectedBS, game.BoardWidth)
+			assert.Equal(t, tt.expectedBS, game.BoardHeight)
 			assert.Equal(t, tt.expectedWL, game.WinningLength)
 			assert.Equal(t, StatusPending, game.Status)
 			assert.Equal(t, "player1", game.Player1ID)
@@ -53,6 +55,39 @@ func TestGame_JoinPlayer(t *testing.T) {
 	assert.Equal(t, ErrGameFull, err)
 }
 
+func TestGame_JoinPrivateGame(t *testing.T) {
+	game := NewPrivateGame("player1", GameSettings{BoardSize: 3, WinningLength: 3}, "player2")
+	assert.True(t, game.Private)
+	assert.Len(t, game.JoinCode, 6)
+
+	assert.Equal(t, ErrNotInvited, game.JoinPlayer("player3"))
+	assert.Equal(t, ErrNotInvited, game.JoinPlayerWithCode("player3", "WRONG1"))
+	assert.NoError(t, game.JoinPlayer("player2"))
+	assert.Equal(t, StatusInProgress, game.Status)
+
+	open := NewPrivateGame("player1", GameSettings{BoardSize: 3, WinningLength: 3}, "")
+	assert.Equal(t, ErrNotInvited, open.JoinPlayer("player2"))
+	assert.NoError(t, open.JoinPlayerWithCode("player3", open.JoinCode))
+	assert.Equal(t, "player3", open.Player2ID)
+
+	// Public games have no code to join with
+	public := NewGame("player1", 3, 3)
+	assert.Equal(t, ErrNotInvited, public.JoinPlayerWithCode("player2", ""))
+}
+
+func TestGame_JoinWithoutPlayerID(t *testing.T) {
+	// A private game without an invitee has an empty InvitedUserID
+	private := NewPrivateGame("player1", GameSettings{BoardSize: 3}, "")
+	assert.Equal(t, ErrInvalidPlayer, private.JoinPlayer(""))
+	assert.Equal(t, ErrInvalidPlayer, private.JoinPlayerWithCode("", private.JoinCode))
+	assert.Equal(t, []string{"player1"}, private.Players)
+	assert.Equal(t, StatusPending, private.Status)
+
+	public := NewGame("player1", 3, 3)
+	assert.Equal(t, ErrInvalidPlayer, public.JoinPlayer(""))
+	assert.Empty(t, public.Player2ID)
+}
+
 func TestGame_MakeMove(t *testing.T) {
 	game := NewGame("player1", 3, 3)
 	game.JoinPlayer("player2")
@@ -97,6 +132,73 @@ func TestGame_WinConditions(t *testing.T) {
 	assert.Equal(t, "player1", game.WinnerID)
 }
 
+func TestGame_RectangularBoard(t *testing.T) {
+	tests := []struct {
+		name   string
+		stones []Position
+		move   Position
+		win    bool
+	}{
+		{"horizontal at the right edge", []Position{{2, 2}, {2, 3}}, Position{2, 4}, true},
+		{"vertical in the last column", []Position{{0, 4}, {1, 4}}, Position{2, 4}, true},
+		{"diagonal", []Position{{0, 2}, {1, 3}}, Position{2, 4}, true},
+		{"anti-diagonal", []Position{{0, 4}, {1, 3}}, Position{2, 2}, true},
+		{"no wrap around rows", []Position{{0, 3}, {0, 4}}, Position{1, 0}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := NewGameWithSettings("player1", GameSettings{BoardWidth: 5, BoardHeight: 3, WinningLength: 3})
+			require.NoError(t, game.JoinPlayer("player2"))
+			for _, pos := range tt.stones {
+				game.Board[pos.Row][pos.Col] = "X"
+			}
+
+			require.NoError(t, game.MakeMove("player1", tt.move))
+			assert.Equal(t, tt.win, game.Status == StatusFinishedWin)
+		})
+	}
+
+	// Lines must fit along the longer side and default to the shorter one
+	assert.Equal(t, 7, NewGameWithSettings("player1", GameSettings{BoardWidth: 7, BoardHeight: 6, WinningLength: 7}).WinningLength)
+	assert.Equal(t, 6, NewGameWithSettings("player1", GameSettings{BoardWidth: 7, BoardHeight: 6, WinningLength: 8}).WinningLength)
+	assert.Equal(t, 6, NewGameWithSettings("player1", GameSettings{BoardWidth: 7, BoardHeight: 6}).WinningLength)
+}
+
+func TestGame_MultiPlayer(t *testing.T) {
+	game := NewGameWithSettings("player1", GameSettings{BoardSize: 4, WinningLength: 3, Seats: 3})
+	assert.Equal(t, 3, game.Seats)
+
+	// The game only starts once every seat is filled
+	require.NoError(t, game.JoinPlayer("player2"))
+	assert.Equal(t, StatusPending, game.Status)
+	assert.Equal(t, "player2", game.Player2ID)
+	assert.Equal(t, 1, game.OpenSeats())
+	assert.Equal(t, ErrGameFull, game.JoinPlayer("player2"))
+	require.NoError(t, game.JoinPlayer("player3"))
+	assert.Equal(t, StatusInProgress, game.Status)
+	assert.Equal(t, []string{"player1", "player2", "player3"}, game.Players)
+	assert.Equal(t, ErrGameFull, game.JoinPlayer("player4"))
+
+	assert.Equal(t, "Y", game.GetPlayerSymbol("player3"))
+	assert.Equal(t, ErrTwoPlayerOnly, game.OfferDraw("player1"))
+
+	// Turns go round the table in seat order
+	assert.Equal(t, ErrNotPlayersTurn, game.MakeMove("player3", Position{2, 0}))
+	moves := []Position{{0, 0}, {1, 0}, {2, 0}, {0, 1}, {1, 1}, {2, 1}}
+	for i, move := range moves {
+		require.NoError(t, game.MakeMove(game.Players[i%3], move))
+	}
+	assert.Equal(t, "Y", game.Board[2][1])
+	assert.Equal(t, "player1", game.CurrentPlayer)
+	require.NoError(t, game.MakeMove("player1", Position{0, 2}))
+	assert.Equal(t, StatusFinishedWin, game.Status)
+	assert.Equal(t, "player1", game.WinnerID)
+
+	assert.Equal(t, MaxSeats, NewGameWithSettings("player1", GameSettings{Seats: 9}).Seats)
+	assert.Equal(t, 2, NewGameWithSettings("player1", GameSettings{}).Seats)
+	assert.Equal(t, 2, NewGameWithSettings("player1", GameSettings{Variant: VariantMisere, Seats: 3}).Seats)
+}
+
 func TestGame_DrawCondition(t *testing.T) {
 	game := NewGame("player1", 3, 3)
 	game.JoinPlayer("player2")
@@ -119,20 +221,62 @@ func TestGame_DrawCondition(t *testing.T) {
 	assert.Equal(t, StatusFinishedDraw, game.Status)
 }
 
+func TestGame_ForceEnd(t *testing.T) {
+	pending := NewGame("player1", 3, 3)
+	assert.Equal(t, ErrGameNotStarted, pending.ForceEnd("player1", false))
+	assert.Equal(t, ErrGameNotStarted, pending.ForceEnd("", true))
+	assert.NoError(t, pending.ForceEnd("", false))
+	assert.Equal(t, StatusAbandoned, pending.Status)
+	assert.True(t, pending.IsFinished())
+
+	game := NewGame("player1", 3, 3)
+	game.JoinPlayer("player2")
+	assert.Equal(t, ErrPlayerNotInGame, game.ForceEnd("player3", false))
+	assert.NoError(t, game.ForceEnd("player2", false))
+	assert.Equal(t, StatusFinishedWin, game.Status)
+	assert.Equal(t, "player2", game.WinnerID)
+	assert.Equal(t, ErrGameFinished, game.ForceEnd("", false))
+
+	draw := NewGame("player1", 3, 3)
+	draw.JoinPlayer("player2")
+	assert.NoError(t, draw.ForceEnd("", true))
+	assert.Equal(t, StatusFinishedDraw, draw.Status)
+}
+
+func TestGame_Spectators(t *testing.T) {
+	game := NewGame("player1", 3, 3)
+	game.JoinPlayer("player2")
+	assert.True(t, game.SpectatorsAllowed)
+	assert.True(t, game.CanSpectate("viewer"))
+
+	// Only the creator may close the game to spectators
+	assert.Equal(t, ErrNotGameCreator, game.SetSpectatorsAllowed("player2", false))
+	assert.Equal(t, ErrPlayerNotInGame, game.SetSpectatorsAllowed("viewer", false))
+	assert.NoError(t, game.SetSpectatorsAllowed("player1", false))
+	assert.False(t, game.CanSpectate("viewer"))
+	assert.True(t, game.CanSpectate("player2"))
+
+	// Private games are closed to spectators by default
+	assert.False(t, NewPrivateGame("player1", GameSettings{BoardSize: 3, WinningLength: 3}, "").SpectatorsAllowed)
+}
+
 func TestPosition_IsValid(t *testing.T) {
 	tests := []struct {
-		pos       Position
-		boardSize int
-		expected  bool
+		pos      Position
+		width    int
+		height   int
+		expected bool
 	}{
-		{Position{0, 0}, 3, true},
-		{Position{2, 2}, 3, true},
-		{Position{-1, 0}, 3, false},
-		{Position{0, 3}, 3, false},
-		{Position{3, 0}, 3, false},
+		{Position{0, 0}, 3, 3, true},
+		{Position{2, 2}, 3, 3, true},
+		{Position{-1, 0}, 3, 3, false},
+		{Position{0, 3}, 3, 3, false},
+		{Position{3, 0}, 3, 3, false},
+		{Position{5, 6}, 7, 6, true},
+		{Position{6, 5}, 7, 6, false},
 	}
 
 	for _, tt := range tests {
-		assert.Equal(t, tt.expected, tt.pos.IsValid(tt.boardSize))
+		assert.Equal(t, tt.expected, tt.pos.IsValid(tt.width, tt.height))
 	}
 }
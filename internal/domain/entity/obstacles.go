@@ -0,0 +1,106 @@
+// internal/domain/entity/obstacles.go
+package entity
+
+import (
+	"errors"
+	"math/rand/v2"
+)
+
+// BlockedCell marks a board cell nobody may play on. Blocked cells belong
+// to no player, so they break every line through them.
+const BlockedCell = "#"
+
+var (
+	ErrCellBlocked      = errors.New("cell is blocked")
+	ErrInvalidPlacement = errors.New("invalid obstacle or handicap stone")
+)
+
+// HasCustomSetup reports whether the settings ask for obstacles or
+// handicap stones.
+func (s GameSettings) HasCustomSetup() bool {
+	return len(s.Obstacles) > 0 || s.RandomObstacles > 0 || len(s.HandicapStones) > 0
+}
+
+// HasCustomSetup reports whether the game has blocked cells or handicap
+// stones.
+func (g *Game) HasCustomSetup() bool {
+	return len(g.HandicapStones) > 0 || len(g.Obstacles()) > 0
+}
+
+// ValidatePlacements checks the obstacles and handicap stones against the
+// board the settings describe: every position must be on the board and
+// used once, obstacles may cover at most half of the board, and there must
+// be too few handicap stones to threaten a line of WinningLength before the
+// first player has moved.
+func (s GameSettings) ValidatePlacements() error {
+	width, height := s.Dimensions()
+	if s.RandomObstacles < 0 {
+		return ErrInvalidPlacement
+	}
+	if 2*(len(s.Obstacles)+s.RandomObstacles+len(s.HandicapStones)) > width*height {
+		return ErrInvalidPlacement
+	}
+	if len(s.HandicapStones) > 0 && len(s.HandicapStones) >= s.WinningLength-1 {
+		return ErrInvalidPlacement
+	}
+
+	seen := make(map[Position]bool, len(s.Obstacles)+len(s.HandicapStones))
+	for _, pos := range append(append([]Position(nil), s.Obstacles...), s.HandicapStones...) {
+		if !pos.IsValid(width, height) || seen[pos] {
+			return ErrInvalidPlacement
+		}
+		seen[pos] = true
+	}
+	return nil
+}
+
+// placeSetup blocks the listed obstacles, places the handicap stones for
+// the second seat and then blocks RandomObstacles more empty cells. Positions
+// that are off the board or already taken are skipped.
+func (g *Game) placeSetup(settings GameSettings) {
+	place := func(pos Position, cell string) bool {
+		if !pos.IsValid(g.BoardWidth, g.BoardHeight) || g.Board[pos.Row][pos.Col] != "" {
+			return false
+		}
+		g.Board[pos.Row][pos.Col] = cell
+		return true
+	}
+
+	for _, pos := range settings.Obstacles {
+		place(pos, BlockedCell)
+	}
+	for _, pos := range settings.HandicapStones {
+		if place(pos, playerSymbols[1]) {
+			g.HandicapStones = append(g.HandicapStones, pos)
+		}
+	}
+
+	if settings.RandomObstacles <= 0 {
+		return
+	}
+	var empty []Position
+	for r := range g.Board {
+		for c := range g.Board[r] {
+			if g.Board[r][c] == "" {
+				empty = append(empty, Position{Row: r, Col: c})
+			}
+		}
+	}
+	rand.Shuffle(len(empty), func(i, j int) { empty[i], empty[j] = empty[j], empty[i] })
+	for _, pos := range empty[:min(settings.RandomObstacles, len(empty))] {
+		place(pos, BlockedCell)
+	}
+}
+
+// Obstacles returns the blocked cells, row by row.
+func (g *Game) Obstacles() []Position {
+	var obstacles []Position
+	for r := range g.Board {
+		for c := range g.Board[r] {
+			if g.Board[r][c] == BlockedCell {
+				obstacles = append(obstacles, Position{Row: r, Col: c})
+			}
+		}
+	}
+	return obstacles
+}
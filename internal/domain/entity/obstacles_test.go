@@ -0,0 +1,113 @@
+// internal/domain/entity/obstacles_test.go
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGame_Obstacles(t *testing.T) {
+	game := NewGameWithSettings("player1", GameSettings{
+		BoardSize:     4,
+		WinningLength: 3,
+		Obstacles:     []Position{{0, 1}, {1, 1}},
+	})
+	require.NoError(t, game.JoinPlayer("player2"))
+	assert.Equal(t, BlockedCell, game.Board[0][1])
+	assert.Equal(t, []Position{{0, 1}, {1, 1}}, game.Obstacles())
+	assert.True(t, game.HasCustomSetup())
+
+	assert.Equal(t, ErrCellBlocked, game.MakeMove("player1", Position{0, 1}))
+
+	// A blocked cell breaks the top row, so X needs a line elsewhere
+	moves := []Position{{0, 0}, {3, 3}, {0, 2}, {3, 2}, {0, 3}, {2, 0}}
+	for i, move := range moves {
+		require.NoError(t, game.MakeMove(game.Players[i%2], move))
+	}
+	assert.Equal(t, StatusInProgress, game.Status)
+	require.NoError(t, game.MakeMove("player1", Position{1, 2}))
+	require.NoError(t, game.MakeMove("player2", Position{3, 1}))
+	assert.Equal(t, StatusFinishedWin, game.Status)
+	assert.Equal(t, "player2", game.WinnerID)
+}
+
+func TestGame_RandomObstacles(t *testing.T) {
+	game := NewGameWithSettings("player1", GameSettings{
+		BoardSize:       5,
+		Obstacles:       []Position{{2, 2}},
+		RandomObstacles: 4,
+	})
+	obstacles := game.Obstacles()
+	assert.Len(t, obstacles, 5)
+	assert.Contains(t, obstacles, Position{2, 2})
+
+	// Rematches set up the same board
+	assert.Equal(t, obstacles, NewGameWithSettings("player2", game.Settings()).Obstacles())
+}
+
+func TestGame_HandicapStones(t *testing.T) {
+	game := NewGameWithSettings("player1", GameSettings{
+		BoardSize:      5,
+		WinningLength:  4,
+		Obstacles:      []Position{{0, 0}},
+		HandicapStones: []Position{{2, 2}, {0, 0}},
+	})
+	// Stones are only placed on free cells
+	assert.Equal(t, []Position{{2, 2}}, game.HandicapStones)
+	assert.Equal(t, "O", game.Board[2][2])
+
+	require.NoError(t, game.JoinPlayer("player2"))
+	assert.Equal(t, "player1", game.CurrentPlayer)
+	assert.Equal(t, ErrPositionOccupied, game.MakeMove("player1", Position{2, 2}))
+	assert.Empty(t, game.Moves)
+}
+
+func TestGame_HandicapRematch(t *testing.T) {
+	game := NewGameWithSettings("strong", GameSettings{
+		BoardSize:      5,
+		WinningLength:  4,
+		HandicapStones: []Position{{2, 2}},
+	})
+	require.NoError(t, game.JoinPlayer("weak"))
+	require.NoError(t, game.ForceEnd("strong", false))
+	require.NoError(t, game.OfferRematch("weak", time.Now().Add(time.Minute)))
+
+	// The stones stay with the weaker player, who still moves second
+	rematch, err := game.AcceptRematch("strong", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"strong", "weak"}, rematch.Players)
+	assert.Equal(t, "strong", rematch.CurrentPlayer)
+	assert.Equal(t, []Position{{2, 2}}, rematch.HandicapStones)
+	assert.Equal(t, rematch.GetPlayerSymbol("weak"), rematch.Board[2][2])
+}
+
+func TestGameSettings_ValidatePlacements(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings GameSettings
+		valid    bool
+	}{
+		{"none", GameSettings{BoardSize: 3, WinningLength: 3}, true},
+		{"obstacles", GameSettings{BoardSize: 3, WinningLength: 3, Obstacles: []Position{{1, 1}}, RandomObstacles: 2}, true},
+		{"handicap", GameSettings{BoardSize: 5, WinningLength: 4, HandicapStones: []Position{{0, 0}, {4, 4}}}, true},
+		{"off the board", GameSettings{BoardSize: 3, WinningLength: 3, Obstacles: []Position{{3, 0}}}, false},
+		{"off a rectangular board", GameSettings{BoardWidth: 5, BoardHeight: 3, WinningLength: 3, Obstacles: []Position{{4, 0}}}, false},
+		{"used twice", GameSettings{BoardSize: 3, WinningLength: 3, Obstacles: []Position{{1, 1}}, HandicapStones: []Position{{1, 1}}}, false},
+		{"negative random count", GameSettings{BoardSize: 3, WinningLength: 3, RandomObstacles: -1}, false},
+		{"more than half blocked", GameSettings{BoardSize: 3, WinningLength: 3, RandomObstacles: 5}, false},
+		{"too many handicap stones", GameSettings{BoardSize: 5, WinningLength: 4, HandicapStones: []Position{{0, 0}, {2, 2}, {4, 4}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.ValidatePlacements()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidPlacement)
+			}
+		})
+	}
+}
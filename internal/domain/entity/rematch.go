@@ -0,0 +1,145 @@
+// internal/domain/entity/rematch.go
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrGameNotFinished = errors.New("game is not finished")
+	ErrRematchOffered  = errors.New("rematch already offered")
+	ErrNoRematchOffer  = errors.New("no rematch offer to answer")
+)
+
+type RematchStatus int
+
+const (
+	RematchNone RematchStatus = iota
+	RematchOffered
+	RematchAccepted
+	RematchDeclined
+	RematchExpired
+)
+
+func (s RematchStatus) String() string {
+	switch s {
+	case RematchNone:
+		return "none"
+	case RematchOffered:
+		return "offered"
+	case RematchAccepted:
+		return "accepted"
+	case RematchDeclined:
+		return "declined"
+	case RematchExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// Rematch tracks an offer, made after a game ends, to play it again.
+type Rematch struct {
+	Status    RematchStatus
+	OfferedBy string
+	ExpiresAt time.Time
+	// GameID is the new game once the offer is accepted.
+	GameID string
+}
+
+// OfferRematch opens a rematch offer from playerID to the opponent until
+// expiresAt. A declined or expired offer may be renewed. Only two-player
+// games can be rematched.
+func (g *Game) OfferRematch(playerID string, expiresAt time.Time) error {
+	if !g.IsPlayerInGame(playerID) {
+		return ErrPlayerNotInGame
+	}
+	if g.Seats > 2 {
+		return ErrTwoPlayerOnly
+	}
+	if !g.IsFinished() {
+		return ErrGameNotFinished
+	}
+	if g.Player2ID == "" {
+		return ErrGameNotStarted
+	}
+	if g.Rematch.Status == RematchOffered || g.Rematch.Status == RematchAccepted {
+		return ErrRematchOffered
+	}
+
+	g.Rematch = Rematch{Status: RematchOffered, OfferedBy: playerID, ExpiresAt: expiresAt}
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+// AcceptRematch accepts the opponent's open offer and returns the new game,
+// already in progress between the same players with colors swapped. Handicap
+// stones are given to the weaker player, so a game with them keeps its seats
+// instead.
+func (g *Game) AcceptRematch(playerID string, now time.Time) (*Game, error) {
+	if err := g.answerable(playerID, now); err != nil {
+		return nil, err
+	}
+
+	first, second := g.Player2ID, g.Player1ID
+	if len(g.HandicapStones) > 0 {
+		first, second = g.Player1ID, g.Player2ID
+	}
+	rematch := NewGameWithSettings(first, g.Settings())
+	rematch.Private = g.Private
+	rematch.SpectatorsAllowed = g.SpectatorsAllowed
+	if err := rematch.join(second); err != nil {
+		return nil, err
+	}
+
+	g.Rematch.Status = RematchAccepted
+	g.Rematch.GameID = rematch.ID
+	g.UpdatedAt = now
+	return rematch, nil
+}
+
+// DeclineRematch turns down the opponent's open offer.
+func (g *Game) DeclineRematch(playerID string, now time.Time) error {
+	if err := g.answerable(playerID, now); err != nil {
+		return err
+	}
+
+	g.Rematch.Status = RematchDeclined
+	g.UpdatedAt = now
+	return nil
+}
+
+// ExpireRematch closes an open offer that has outlived its deadline and
+// reports whether it did.
+func (g *Game) ExpireRematch(now time.Time) bool {
+	if g.Rematch.Status != RematchOffered || now.Before(g.Rematch.ExpiresAt) {
+		return false
+	}
+	g.Rematch.Status = RematchExpired
+	g.UpdatedAt = now
+	return true
+}
+
+// RematchSettled reports whether the offer after a finished game has been
+// answered or has lapsed.
+func (g *Game) RematchSettled() bool {
+	switch g.Rematch.Status {
+	case RematchAccepted, RematchDeclined, RematchExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// answerable checks that playerID may answer an open, unexpired offer.
+func (g *Game) answerable(playerID string, now time.Time) error {
+	if !g.IsPlayerInGame(playerID) {
+		return ErrPlayerNotInGame
+	}
+	g.ExpireRematch(now)
+	if g.Rematch.Status != RematchOffered || g.Rematch.OfferedBy == playerID {
+		return ErrNoRematchOffer
+	}
+	return nil
+}
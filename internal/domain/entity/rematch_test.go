@@ -0,0 +1,78 @@
+// internal/domain/entity/rematch_test.go
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func finishedGame(t *testing.T) *Game {
+	t.Helper()
+	game := NewGame("player1", 3, 3)
+	require.NoError(t, game.JoinPlayer("player2"))
+	require.NoError(t, game.ForceEnd("player1", false))
+	return game
+}
+
+func TestGame_OfferRematch(t *testing.T) {
+	now := time.Now()
+
+	inProgress := NewGame("player1", 3, 3)
+	inProgress.JoinPlayer("player2")
+	assert.Equal(t, ErrGameNotFinished, inProgress.OfferRematch("player1", now.Add(time.Minute)))
+
+	abandoned := NewGame("player1", 3, 3)
+	abandoned.ForceEnd("", false)
+	assert.Equal(t, ErrGameNotStarted, abandoned.OfferRematch("player1", now.Add(time.Minute)))
+
+	game := finishedGame(t)
+	assert.Equal(t, ErrPlayerNotInGame, game.OfferRematch("player3", now.Add(time.Minute)))
+	assert.NoError(t, game.OfferRematch("player2", now.Add(time.Minute)))
+	assert.Equal(t, RematchOffered, game.Rematch.Status)
+	assert.Equal(t, ErrRematchOffered, game.OfferRematch("player1", now.Add(time.Minute)))
+
+	// Declined offers may be renewed
+	assert.NoError(t, game.DeclineRematch("player1", now))
+	assert.Equal(t, RematchDeclined, game.Rematch.Status)
+	assert.NoError(t, game.OfferRematch("player1", now.Add(time.Minute)))
+}
+
+func TestGame_AcceptRematch(t *testing.T) {
+	now := time.Now()
+	game := finishedGame(t)
+	require.NoError(t, game.OfferRematch("player1", now.Add(time.Minute)))
+
+	// Only the opponent can answer
+	_, err := game.AcceptRematch("player1", now)
+	assert.Equal(t, ErrNoRematchOffer, err)
+
+	rematch, err := game.AcceptRematch("player2", now)
+	require.NoError(t, err)
+	assert.Equal(t, "player2", rematch.Player1ID)
+	assert.Equal(t, "player1", rematch.Player2ID)
+	assert.Equal(t, "player2", rematch.CurrentPlayer)
+	assert.Equal(t, StatusInProgress, rematch.Status)
+	assert.Equal(t, game.Settings(), rematch.Settings())
+	assert.Equal(t, game.WinningLength, rematch.WinningLength)
+	assert.Equal(t, RematchAccepted, game.Rematch.Status)
+	assert.Equal(t, rematch.ID, game.Rematch.GameID)
+
+	assert.Equal(t, ErrRematchOffered, game.OfferRematch("player2", now.Add(time.Minute)))
+}
+
+func TestGame_ExpireRematch(t *testing.T) {
+	now := time.Now()
+	game := finishedGame(t)
+	require.NoError(t, game.OfferRematch("player1", now.Add(time.Minute)))
+
+	assert.False(t, game.ExpireRematch(now))
+	assert.Equal(t, RematchOffered, game.Rematch.Status)
+
+	_, err := game.AcceptRematch("player2", now.Add(2*time.Minute))
+	assert.Equal(t, ErrNoRematchOffer, err)
+	assert.Equal(t, RematchExpired, game.Rematch.Status)
+	assert.False(t, game.ExpireRematch(now.Add(2*time.Minute)))
+}
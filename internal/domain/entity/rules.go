@@ -0,0 +1,98 @@
+// internal/domain/entity/rules.go
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrUnknownVariant = errors.New("unknown game variant")
+
+// Rules decide how a game is played: which moves are legal, where a move
+// lands, when the game is over and who moves next. A Game delegates to the
+// Rules registered for its Variant, so variants can be added without
+// changing Game itself. Implementations must be stateless.
+type Rules interface {
+	// ValidateMove checks that playerID, whose turn it is, may play pos.
+	ValidateMove(g *Game, playerID string, pos Position) error
+	// ApplyMove places playerID's piece for a validated move at pos and
+	// returns the cell it ended up on.
+	ApplyMove(g *Game, playerID string, pos Position) Position
+	// Outcome reports whether the move playerID just made at pos ended the
+	// game.
+	Outcome(g *Game, playerID string, pos Position) Outcome
+	// NextPlayer returns the player to move after playerID.
+	NextPlayer(g *Game, playerID string) string
+}
+
+// Outcome is the result of a move. A finished game without a winner is a
+// draw.
+type Outcome struct {
+	Finished bool
+	WinnerID string
+}
+
+// SettingsAdjuster is implemented by Rules that only work with particular
+// settings, such as a fixed board. New games of the variant are created with
+// the adjusted settings.
+type SettingsAdjuster interface {
+	AdjustSettings(settings GameSettings) GameSettings
+}
+
+// SettingsValidator is implemented by Rules that cannot be played with some
+// settings at all, which are rejected rather than adjusted.
+type SettingsValidator interface {
+	ValidateSettings(settings GameSettings) error
+}
+
+// VariantStandard is the variant of games created without one.
+const VariantStandard = "standard"
+
+var variants = map[string]Rules{
+	VariantStandard: StandardRules{},
+	VariantGomoku:   GomokuRules{},
+	VariantRenju:    RenjuRules{},
+	VariantMisere:   MisereRules{},
+	VariantGravity:  GravityRules{},
+	VariantUltimate: UltimateRules{},
+}
+
+// RegisterVariant makes rules available to new games under name. It is
+// meant to be called from init functions and panics if name is taken.
+func RegisterVariant(name string, rules Rules) {
+	if _, exists := variants[name]; exists {
+		panic(fmt.Sprintf("entity: variant %q registered twice", name))
+	}
+	variants[name] = rules
+}
+
+// LookupVariant returns the rules registered under name; an empty name
+// selects the standard rules.
+func LookupVariant(name string) (Rules, error) {
+	if name == "" {
+		name = VariantStandard
+	}
+	rules, ok := variants[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownVariant, name)
+	}
+	return rules, nil
+}
+
+// Variants returns the names of all registered variants, sorted.
+func Variants() []string {
+	names := make([]string, 0, len(variants))
+	for name := range variants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Rules returns the rules the game is played by, or ErrUnknownVariant if
+// its variant is no longer registered, e.g. a stored game from a build that
+// had it.
+func (g *Game) Rules() (Rules, error) {
+	return LookupVariant(g.Variant)
+}
@@ -0,0 +1,189 @@
+// internal/domain/entity/rules_gomoku.go
+package entity
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// VariantGomoku only counts lines of exactly WinningLength as a win.
+	VariantGomoku = "gomoku"
+	// VariantRenju is gomoku with renju restrictions on the first player.
+	VariantRenju = "renju"
+)
+
+// ErrForbiddenMove is wrapped by the errors for moves the renju restrictions
+// forbid, so callers can tell which restriction was hit.
+var (
+	ErrForbiddenMove = errors.New("forbidden move")
+	ErrOverline      = fmt.Errorf("%w: overline", ErrForbiddenMove)
+	ErrDoubleFour    = fmt.Errorf("%w: double four", ErrForbiddenMove)
+	ErrDoubleThree   = fmt.Errorf("%w: double three", ErrForbiddenMove)
+)
+
+// GomokuRules are the standard rules except that only a line of exactly
+// WinningLength wins; longer lines (overlines) do not.
+type GomokuRules struct {
+	StandardRules
+}
+
+func (GomokuRules) Outcome(g *Game, playerID string, pos Position) Outcome {
+	symbol := g.GetPlayerSymbol(playerID)
+	if hasExactLine(g, pos, symbol) {
+		return Outcome{Finished: true, WinnerID: playerID}
+	}
+	return drawOutcome(g, playerID)
+}
+
+// RenjuRules are the gomoku rules with restrictions that offset the first
+// player's advantage: the first player may not make an overline, two fours
+// or two open threes with one move, unless that move completes a line of
+// exactly WinningLength. The second player is unrestricted and also wins
+// with an overline.
+//
+// A three counts as open if one more stone makes an open four; unlike
+// tournament renju, whether that stone would itself be forbidden is not
+// considered.
+type RenjuRules struct {
+	GomokuRules
+}
+
+func (RenjuRules) ValidateMove(g *Game, playerID string, pos Position) error {
+	if err := (StandardRules{}).ValidateMove(g, playerID, pos); err != nil {
+		return err
+	}
+	if playerID != g.Player1ID {
+		return nil
+	}
+	return renjuViolation(g, pos, g.GetPlayerSymbol(playerID))
+}
+
+func (RenjuRules) Outcome(g *Game, playerID string, pos Position) Outcome {
+	if playerID != g.Player1ID {
+		return StandardRules{}.Outcome(g, playerID, pos)
+	}
+	return GomokuRules{}.Outcome(g, playerID, pos)
+}
+
+// hasExactLine reports whether pos is on a line of exactly WinningLength
+// symbols.
+func hasExactLine(g *Game, pos Position, symbol string) bool {
+	for _, dir := range directions {
+		if countInDirection(g, pos, symbol, dir[0], dir[1]) == g.WinningLength {
+			return true
+		}
+	}
+	return false
+}
+
+// renjuViolation returns the restriction that placing symbol on the empty
+// cell pos would break, or nil if the move is allowed.
+func renjuViolation(g *Game, pos Position, symbol string) error {
+	g.Board[pos.Row][pos.Col] = symbol
+	defer func() { g.Board[pos.Row][pos.Col] = "" }()
+
+	if hasExactLine(g, pos, symbol) {
+		return nil
+	}
+	if longestLine(g, pos, symbol) > g.WinningLength {
+		return ErrOverline
+	}
+
+	fours, threes := 0, 0
+	for _, dir := range directions {
+		lineFours := countFours(g, pos, symbol, dir)
+		switch {
+		case lineFours > 0:
+			fours += lineFours
+		case isOpenThree(g, pos, symbol, dir):
+			threes++
+		}
+	}
+	if fours >= 2 {
+		return ErrDoubleFour
+	}
+	if threes >= 2 {
+		return ErrDoubleThree
+	}
+	return nil
+}
+
+// completions returns the empty cells on the line through pos along dir
+// that would extend it to exactly WinningLength symbols.
+func completions(g *Game, pos Position, symbol string, dir [2]int) []Position {
+	var cells []Position
+	forEachEmptyOnLine(g, pos, dir, func(cell Position) {
+		g.Board[cell.Row][cell.Col] = symbol
+		if countInDirection(g, pos, symbol, dir[0], dir[1]) == g.WinningLength {
+			cells = append(cells, cell)
+		}
+		g.Board[cell.Row][cell.Col] = ""
+	})
+	return cells
+}
+
+// countFours returns the number of fours through pos along dir: groups of
+// WinningLength-1 symbols that one more extends to exactly WinningLength. An
+// open four completes at either end but is one four; two fours on the same
+// line, as in X X X _ X _ X X X, count separately.
+func countFours(g *Game, pos Position, symbol string, dir [2]int) int {
+	back := [2]int{-dir[0], -dir[1]}
+	fours := make(map[[2]Position]bool)
+	for _, cell := range completions(g, pos, symbol, dir) {
+		g.Board[cell.Row][cell.Col] = symbol
+		first, last := lineEnd(g, pos, symbol, back), lineEnd(g, pos, symbol, dir)
+		g.Board[cell.Row][cell.Col] = ""
+
+		// A four is told apart by its outermost stones, which exclude the
+		// completing cell
+		if first == cell {
+			first = Position{Row: first.Row + dir[0], Col: first.Col + dir[1]}
+		}
+		if last == cell {
+			last = Position{Row: last.Row - dir[0], Col: last.Col - dir[1]}
+		}
+		fours[[2]Position{first, last}] = true
+	}
+	return len(fours)
+}
+
+// lineEnd returns the last cell of the run of symbol from pos along dir.
+func lineEnd(g *Game, pos Position, symbol string, dir [2]int) Position {
+	for {
+		next := Position{Row: pos.Row + dir[0], Col: pos.Col + dir[1]}
+		if !next.IsValid(g.BoardWidth, g.BoardHeight) || g.Board[next.Row][next.Col] != symbol {
+			return pos
+		}
+		pos = next
+	}
+}
+
+// isOpenThree reports whether one more symbol on the line through pos along
+// dir would make an open four: WinningLength-1 in a row that can be
+// completed at either end.
+func isOpenThree(g *Game, pos Position, symbol string, dir [2]int) bool {
+	open := false
+	forEachEmptyOnLine(g, pos, dir, func(cell Position) {
+		if open {
+			return
+		}
+		g.Board[cell.Row][cell.Col] = symbol
+		open = countInDirection(g, pos, symbol, dir[0], dir[1]) == g.WinningLength-1 &&
+			len(completions(g, pos, symbol, dir)) >= 2
+		g.Board[cell.Row][cell.Col] = ""
+	})
+	return open
+}
+
+// forEachEmptyOnLine calls fn for every empty cell within WinningLength of
+// pos on the line through it along dir.
+func forEachEmptyOnLine(g *Game, pos Position, dir [2]int, fn func(Position)) {
+	for offset := -g.WinningLength; offset <= g.WinningLength; offset++ {
+		cell := Position{Row: pos.Row + dir[0]*offset, Col: pos.Col + dir[1]*offset}
+		if offset == 0 || !cell.IsValid(g.BoardWidth, g.BoardHeight) || g.Board[cell.Row][cell.Col] != "" {
+			continue
+		}
+		fn(cell)
+	}
+}
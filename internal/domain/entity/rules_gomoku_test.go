@@ -0,0 +1,123 @@
+// internal/domain/entity/rules_gomoku_test.go
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gomokuGame returns a started 15x15 five-in-a-row game of variant with the
+// given stones already on the board and player1 to move.
+func gomokuGame(t *testing.T, variant string, stones map[string][]Position) *Game {
+	t.Helper()
+	game := NewGameWithSettings("player1", GameSettings{BoardSize: 15, WinningLength: 5, Variant: variant})
+	require.NoError(t, game.JoinPlayer("player2"))
+	for symbol, positions := range stones {
+		for _, pos := range positions {
+			game.Board[pos.Row][pos.Col] = symbol
+		}
+	}
+	return game
+}
+
+func TestGomokuRules_ExactLength(t *testing.T) {
+	// X X X _ X X on row 7: filling the gap makes six
+	overline := map[string][]Position{"X": {{7, 0}, {7, 1}, {7, 2}, {7, 4}, {7, 5}}}
+
+	standard := gomokuGame(t, VariantStandard, overline)
+	require.NoError(t, standard.MakeMove("player1", Position{7, 3}))
+	assert.Equal(t, StatusFinishedWin, standard.Status)
+
+	gomoku := gomokuGame(t, VariantGomoku, overline)
+	require.NoError(t, gomoku.MakeMove("player1", Position{7, 3}))
+	assert.Equal(t, StatusInProgress, gomoku.Status)
+
+	five := gomokuGame(t, VariantGomoku, map[string][]Position{"X": {{7, 0}, {7, 1}, {7, 2}, {7, 3}}})
+	require.NoError(t, five.MakeMove("player1", Position{7, 4}))
+	assert.Equal(t, StatusFinishedWin, five.Status)
+	assert.Equal(t, "player1", five.WinnerID)
+}
+
+func TestRenjuRules_ForbiddenMoves(t *testing.T) {
+	tests := []struct {
+		name    string
+		stones  []Position
+		blocked []Position
+		move    Position
+		want    error
+	}{
+		{
+			name:   "overline",
+			stones: []Position{{7, 0}, {7, 1}, {7, 2}, {7, 4}, {7, 5}},
+			move:   Position{7, 3},
+			want:   ErrOverline,
+		},
+		{
+			name:   "double four",
+			stones: []Position{{7, 4}, {7, 5}, {7, 6}, {4, 3}, {5, 3}, {6, 3}},
+			move:   Position{7, 3},
+			want:   ErrDoubleFour,
+		},
+		{
+			// X X X _ X _ X X X: the centre stone makes a four on each side
+			name:   "double four on one line",
+			stones: []Position{{7, 0}, {7, 1}, {7, 2}, {7, 6}, {7, 7}, {7, 8}},
+			move:   Position{7, 4},
+			want:   ErrDoubleFour,
+		},
+		{
+			name:   "open four",
+			stones: []Position{{7, 4}, {7, 5}, {7, 6}},
+			move:   Position{7, 3},
+		},
+		{
+			name:   "double three",
+			stones: []Position{{7, 4}, {7, 5}, {5, 3}, {6, 3}},
+			move:   Position{7, 3},
+			want:   ErrDoubleThree,
+		},
+		{
+			name:   "four and three",
+			stones: []Position{{7, 4}, {7, 5}, {7, 6}, {5, 3}, {6, 3}},
+			move:   Position{7, 3},
+		},
+		{
+			name:    "closed three",
+			stones:  []Position{{7, 4}, {7, 5}, {5, 3}, {6, 3}},
+			blocked: []Position{{7, 6}},
+			move:    Position{7, 3},
+		},
+		{
+			name:   "five beats double four",
+			stones: []Position{{7, 4}, {7, 5}, {7, 6}, {7, 7}, {4, 3}, {5, 3}, {6, 3}},
+			move:   Position{7, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := gomokuGame(t, VariantRenju, map[string][]Position{"X": tt.stones, "O": tt.blocked})
+			err := game.MakeMove("player1", tt.move)
+			if tt.want == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.want)
+			assert.ErrorIs(t, err, ErrForbiddenMove)
+			assert.Empty(t, game.Board[tt.move.Row][tt.move.Col])
+			assert.Equal(t, "player1", game.CurrentPlayer)
+		})
+	}
+}
+
+func TestRenjuRules_SecondPlayerUnrestricted(t *testing.T) {
+	game := gomokuGame(t, VariantRenju, map[string][]Position{
+		"O": {{7, 0}, {7, 1}, {7, 2}, {7, 4}, {7, 5}, {5, 3}, {6, 3}},
+	})
+	require.NoError(t, game.MakeMove("player1", Position{0, 14}))
+
+	require.NoError(t, game.MakeMove("player2", Position{7, 3}))
+	assert.Equal(t, StatusFinishedWin, game.Status)
+	assert.Equal(t, "player2", game.WinnerID)
+}
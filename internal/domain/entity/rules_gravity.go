@@ -0,0 +1,52 @@
+// internal/domain/entity/rules_gravity.go
+package entity
+
+import "errors"
+
+// VariantGravity is played like Connect Four: pieces drop down columns.
+const VariantGravity = "gravity"
+
+var ErrColumnFull = errors.New("column is full")
+
+// GravityRules are the standard rules except that a move only chooses a
+// column: the piece drops to the lowest empty row of that column, and the
+// row of the requested position is ignored. Pieces could not rest on
+// obstacles or handicap stones in the middle of a column, so gravity games
+// reject both.
+type GravityRules struct {
+	StandardRules
+}
+
+func (GravityRules) ValidateSettings(settings GameSettings) error {
+	if settings.HasCustomSetup() {
+		return ErrInvalidPlacement
+	}
+	return nil
+}
+
+func (GravityRules) ValidateMove(g *Game, playerID string, pos Position) error {
+	if pos.Col < 0 || pos.Col >= g.BoardWidth {
+		return ErrInvalidMove
+	}
+	if lowestEmptyRow(g, pos.Col) < 0 {
+		return ErrColumnFull
+	}
+	return nil
+}
+
+func (GravityRules) ApplyMove(g *Game, playerID string, pos Position) Position {
+	pos.Row = lowestEmptyRow(g, pos.Col)
+	g.Board[pos.Row][pos.Col] = g.GetPlayerSymbol(playerID)
+	return pos
+}
+
+// lowestEmptyRow returns the bottom-most empty row of col, or -1 if the
+// column is full.
+func lowestEmptyRow(g *Game, col int) int {
+	for row := g.BoardHeight - 1; row >= 0; row-- {
+		if g.Board[row][col] == "" {
+			return row
+		}
+	}
+	return -1
+}
@@ -0,0 +1,47 @@
+// internal/domain/entity/rules_gravity_test.go
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGravityRules(t *testing.T) {
+	game := NewGameWithSettings("player1", GameSettings{BoardWidth: 7, BoardHeight: 6, WinningLength: 4, Variant: VariantGravity})
+	assert.Equal(t, 7, game.BoardWidth)
+	assert.Equal(t, 6, game.BoardHeight)
+	assert.Len(t, game.FlattenBoard(), 42)
+	require.NoError(t, game.JoinPlayer("player2"))
+
+	// Pieces drop to the lowest empty row whatever row is asked for
+	require.NoError(t, game.MakeMove("player1", Position{Row: 0, Col: 3}))
+	assert.Equal(t, "X", game.Board[5][3])
+	require.NoError(t, game.MakeMove("player2", Position{Row: 5, Col: 3}))
+	assert.Equal(t, "O", game.Board[4][3])
+	assert.Equal(t, Position{Row: 4, Col: 3}, game.Moves[1].Position)
+
+	assert.Equal(t, ErrInvalidMove, game.MakeMove("player1", Position{Col: 7}))
+
+	// X stacks four in column 0 while O plays in column 1
+	for i := 0; i < 3; i++ {
+		require.NoError(t, game.MakeMove("player1", Position{Col: 0}))
+		require.NoError(t, game.MakeMove("player2", Position{Col: 1}))
+	}
+	require.NoError(t, game.MakeMove("player1", Position{Col: 0}))
+	assert.Equal(t, StatusFinishedWin, game.Status)
+	assert.Equal(t, "player1", game.WinnerID)
+}
+
+func TestGravityRules_ColumnFull(t *testing.T) {
+	game := NewGameWithSettings("player1", GameSettings{BoardWidth: 4, BoardHeight: 3, WinningLength: 3, Variant: VariantGravity})
+	require.NoError(t, game.JoinPlayer("player2"))
+
+	require.NoError(t, game.MakeMove("player1", Position{Col: 0}))
+	require.NoError(t, game.MakeMove("player2", Position{Col: 0}))
+	require.NoError(t, game.MakeMove("player1", Position{Col: 0}))
+
+	assert.Equal(t, ErrColumnFull, game.MakeMove("player2", Position{Col: 0}))
+	assert.True(t, game.IsTurnOf("player2"))
+}
@@ -0,0 +1,26 @@
+// internal/domain/entity/rules_misere.go
+package entity
+
+// VariantMisere is reverse tic-tac-toe: completing a line loses.
+const VariantMisere = "misere"
+
+// MisereRules are the standard rules with the result reversed: the player
+// who completes a line of WinningLength loses, and the game is drawn once
+// nobody can complete one any more. With a single loser there is no single
+// winner among more than two players, so misère games always seat two.
+type MisereRules struct {
+	StandardRules
+}
+
+func (MisereRules) AdjustSettings(settings GameSettings) GameSettings {
+	settings.Seats = 2
+	return settings
+}
+
+func (r MisereRules) Outcome(g *Game, playerID string, pos Position) Outcome {
+	symbol := g.GetPlayerSymbol(playerID)
+	if longestLine(g, pos, symbol) >= g.WinningLength {
+		return Outcome{Finished: true, WinnerID: r.NextPlayer(g, playerID)}
+	}
+	return drawOutcome(g, playerID)
+}
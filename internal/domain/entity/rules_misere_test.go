@@ -0,0 +1,39 @@
+// internal/domain/entity/rules_misere_test.go
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMisereRules(t *testing.T) {
+	game := NewGameWithSettings("player1", GameSettings{BoardSize: 3, WinningLength: 3, Variant: VariantMisere})
+	require.NoError(t, game.JoinPlayer("player2"))
+
+	require.NoError(t, game.MakeMove("player1", Position{0, 0}))
+	require.NoError(t, game.MakeMove("player2", Position{1, 0}))
+	require.NoError(t, game.MakeMove("player1", Position{0, 1}))
+	require.NoError(t, game.MakeMove("player2", Position{1, 2}))
+	assert.Equal(t, StatusInProgress, game.Status)
+
+	// Completing a line loses
+	require.NoError(t, game.MakeMove("player1", Position{0, 2}))
+	assert.Equal(t, StatusFinishedWin, game.Status)
+	assert.Equal(t, "player2", game.WinnerID)
+}
+
+func TestUserStats_RecordsPerVariant(t *testing.T) {
+	stats := NewUserStats("player1")
+	stats.RecordWin(VariantMisere)
+	stats.RecordDraw("")
+
+	statsCopy := stats.Clone()
+	stats.RecordLoss(VariantMisere)
+
+	assert.Equal(t, 2, statsCopy.TotalGames)
+	assert.Equal(t, VariantStats{Wins: 1, TotalGames: 1}, statsCopy.Variants[VariantMisere])
+	assert.Equal(t, VariantStats{Draws: 1, TotalGames: 1}, statsCopy.Variants[VariantStandard])
+	assert.Equal(t, VariantStats{Wins: 1, Losses: 1, TotalGames: 2}, stats.Variants[VariantMisere])
+}
@@ -0,0 +1,149 @@
+// internal/domain/entity/rules_standard.go
+package entity
+
+import "slices"
+
+// StandardRules are the classic rules: players take turns in seat order
+// placing their symbol on any empty cell, a line of WinningLength in any
+// direction wins, and the game is drawn once nobody can complete a line.
+type StandardRules struct{}
+
+func (StandardRules) ValidateMove(g *Game, playerID string, pos Position) error {
+	if !pos.IsValid(g.BoardWidth, g.BoardHeight) {
+		return ErrInvalidMove
+	}
+	if g.Board[pos.Row][pos.Col] != "" {
+		return ErrPositionOccupied
+	}
+	return nil
+}
+
+func (StandardRules) ApplyMove(g *Game, playerID string, pos Position) Position {
+	g.Board[pos.Row][pos.Col] = g.GetPlayerSymbol(playerID)
+	return pos
+}
+
+func (StandardRules) Outcome(g *Game, playerID string, pos Position) Outcome {
+	symbol := g.GetPlayerSymbol(playerID)
+	if longestLine(g, pos, symbol) >= g.WinningLength {
+		return Outcome{Finished: true, WinnerID: playerID}
+	}
+	return drawOutcome(g, playerID)
+}
+
+func (StandardRules) NextPlayer(g *Game, playerID string) string {
+	seat := slices.Index(g.Players, playerID)
+	return g.Players[(seat+1)%len(g.Players)]
+}
+
+// directions are the four line directions: horizontal, vertical, diagonal
+// and anti-diagonal.
+var directions = [][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+
+// longestLine returns the length of the longest line of symbol through pos.
+func longestLine(g *Game, pos Position, symbol string) int {
+	longest := 0
+	for _, dir := range directions {
+		longest = max(longest, countInDirection(g, pos, symbol, dir[0], dir[1]))
+	}
+	return longest
+}
+
+func countInDirection(g *Game, pos Position, symbol string, deltaRow, deltaCol int) int {
+	count := 1 // Count the current position
+
+	// Count in positive direction
+	r, c := pos.Row+deltaRow, pos.Col+deltaCol
+	for (Position{Row: r, Col: c}).IsValid(g.BoardWidth, g.BoardHeight) && g.Board[r][c] == symbol {
+		count++
+		r += deltaRow
+		c += deltaCol
+	}
+
+	// Count in negative direction
+	r, c = pos.Row-deltaRow, pos.Col-deltaCol
+	for (Position{Row: r, Col: c}).IsValid(g.BoardWidth, g.BoardHeight) && g.Board[r][c] == symbol {
+		count++
+		r -= deltaRow
+		c -= deltaCol
+	}
+
+	return count
+}
+
+func isBoardFull(g *Game) bool {
+	for i := 0; i < g.BoardHeight; i++ {
+		for j := 0; j < g.BoardWidth; j++ {
+			if g.Board[i][j] == "" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// drawOutcome ends the game as a draw once the board is full or nobody can
+// complete a line after playerID has moved.
+func drawOutcome(g *Game, playerID string) Outcome {
+	next := StandardRules{}.NextPlayer(g, playerID)
+	if isBoardFull(g) || isDeadPosition(g, g.GetPlayerSymbol(next)) {
+		return Outcome{Finished: true}
+	}
+	return Outcome{}
+}
+
+// isDeadPosition reports whether nobody can complete a line of
+// WinningLength any more, given that the player with symbol next moves
+// next and the players take turns until the board is full.
+func isDeadPosition(g *Game, next string) bool {
+	empty := 0
+	for _, row := range g.Board {
+		for _, cell := range row {
+			if cell == "" {
+				empty++
+			}
+		}
+	}
+	// Moves left for each symbol if the board were played out
+	seats := max(len(g.Players), 2)
+	first := slices.Index(playerSymbols[:], next)
+	movesLeft := make(map[string]int, seats)
+	for i := 0; i < seats; i++ {
+		symbol := playerSymbols[(first+i)%seats]
+		movesLeft[symbol] = empty / seats
+		if i < empty%seats {
+			movesLeft[symbol]++
+		}
+	}
+
+	for r := 0; r < g.BoardHeight; r++ {
+		for c := 0; c < g.BoardWidth; c++ {
+			for _, dir := range directions {
+				if windowWinnable(g, Position{Row: r, Col: c}, dir[0], dir[1], movesLeft) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// windowWinnable reports whether the WinningLength cells starting at start
+// in the given direction could still all be taken by one player.
+func windowWinnable(g *Game, start Position, deltaRow, deltaCol int, movesLeft map[string]int) bool {
+	end := Position{Row: start.Row + deltaRow*(g.WinningLength-1), Col: start.Col + deltaCol*(g.WinningLength-1)}
+	if !end.IsValid(g.BoardWidth, g.BoardHeight) {
+		return false
+	}
+
+	counts := map[string]int{}
+	for i := 0; i < g.WinningLength; i++ {
+		counts[g.Board[start.Row+deltaRow*i][start.Col+deltaCol*i]]++
+	}
+	for symbol := range movesLeft {
+		if counts[symbol]+counts[""] == g.WinningLength && counts[""] <= movesLeft[symbol] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// internal/domain/entity/rules_test.go
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cornerRules wins the game for whoever first takes a corner.
+type cornerRules struct{ StandardRules }
+
+func (cornerRules) Outcome(g *Game, playerID string, pos Position) Outcome {
+	if (pos.Row == 0 || pos.Row == g.BoardHeight-1) && (pos.Col == 0 || pos.Col == g.BoardWidth-1) {
+		return Outcome{Finished: true, WinnerID: playerID}
+	}
+	return Outcome{}
+}
+
+func init() {
+	RegisterVariant("corner", cornerRules{})
+}
+
+func TestLookupVariant(t *testing.T) {
+	rules, err := LookupVariant("")
+	require.NoError(t, err)
+	assert.Equal(t, StandardRules{}, rules)
+
+	_, err = LookupVariant("nope")
+	assert.ErrorIs(t, err, ErrUnknownVariant)
+
+	assert.Contains(t, Variants(), VariantStandard)
+	assert.Contains(t, Variants(), "corner")
+	assert.Panics(t, func() { RegisterVariant(VariantStandard, StandardRules{}) })
+}
+
+func TestGame_PlaysByVariantRules(t *testing.T) {
+	game := NewGameWithSettings("player1", GameSettings{BoardSize: 3, Variant: "corner"})
+	assert.Equal(t, "corner", game.Variant)
+	require.NoError(t, game.JoinPlayer("player2"))
+
+	require.NoError(t, game.MakeMove("player1", Position{1, 1}))
+	assert.Equal(t, StatusInProgress, game.Status)
+	assert.Equal(t, ErrPositionOccupied, game.MakeMove("player2", Position{1, 1}))
+
+	require.NoError(t, game.MakeMove("player2", Position{2, 2}))
+	assert.Equal(t, StatusFinishedWin, game.Status)
+	assert.Equal(t, "player2", game.WinnerID)
+
+	// Rematches keep the variant
+	require.NoError(t, game.OfferRematch("player1", game.UpdatedAt.Add(time.Minute)))
+	rematch, err := game.AcceptRematch("player2", game.UpdatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, "corner", rematch.Variant)
+
+	assert.Equal(t, VariantStandard, NewGame("player1", 3, 3).Variant)
+}
+
+func TestGame_UnregisteredVariant(t *testing.T) {
+	game := NewGame("player1", 3, 3)
+	require.NoError(t, game.JoinPlayer("player2"))
+	game.Variant = "retired"
+
+	_, err := game.Rules()
+	assert.ErrorIs(t, err, ErrUnknownVariant)
+	assert.ErrorIs(t, game.MakeMove("player1", Position{0, 0}), ErrUnknownVariant)
+	assert.Empty(t, game.Moves)
+	assert.Empty(t, game.Board[0][0])
+}
@@ -0,0 +1,164 @@
+// internal/domain/entity/rules_ultimate.go
+package entity
+
+import "errors"
+
+const (
+	// VariantUltimate is ultimate tic-tac-toe: a 3x3 meta-board of 3x3
+	// local boards.
+	VariantUltimate = "ultimate"
+	// LocalBoardDrawn marks a meta-board cell whose local board filled up
+	// without a winner.
+	LocalBoardDrawn = "-"
+
+	ultimateSize = 3
+)
+
+var (
+	ErrWrongLocalBoard   = errors.New("move must be played in the forced local board")
+	ErrLocalBoardDecided = errors.New("local board is already decided")
+)
+
+// UltimateRules play on a 9x9 board split into nine 3x3 local boards. The
+// cell a move takes within its local board sends the opponent to the local
+// board in the same place on the meta-board; if that board is already
+// decided, the opponent may play in any undecided one. Three in a row on a
+// local board claims its meta-board cell, and three claimed cells in a row
+// on the meta-board win the game. It is drawn once every local board is
+// decided without that.
+//
+// The meta-board and the forced local board are derived from the board and
+// the move history, so takebacks need no special handling.
+type UltimateRules struct {
+	StandardRules
+}
+
+func (UltimateRules) AdjustSettings(settings GameSettings) GameSettings {
+	settings.BoardSize = 0
+	settings.BoardWidth = ultimateSize * ultimateSize
+	settings.BoardHeight = ultimateSize * ultimateSize
+	settings.WinningLength = ultimateSize
+	return settings
+}
+
+// ValidateSettings rejects obstacles and handicap stones, which would take
+// cells the local boards need.
+func (UltimateRules) ValidateSettings(settings GameSettings) error {
+	if settings.HasCustomSetup() {
+		return ErrInvalidPlacement
+	}
+	return nil
+}
+
+func (r UltimateRules) ValidateMove(g *Game, playerID string, pos Position) error {
+	if err := r.StandardRules.ValidateMove(g, playerID, pos); err != nil {
+		return err
+	}
+	local := localBoardOf(pos)
+	if localBoardStatus(g, local) != "" {
+		return ErrLocalBoardDecided
+	}
+	if forced, ok := r.ForcedBoard(g); ok && forced != local {
+		return ErrWrongLocalBoard
+	}
+	return nil
+}
+
+func (r UltimateRules) Outcome(g *Game, playerID string, pos Position) Outcome {
+	meta := r.MetaBoard(g)
+	local := localBoardOf(pos)
+	symbol := g.GetPlayerSymbol(playerID)
+	if meta[local.Row][local.Col] == symbol && longestLineIn(meta, local, symbol) >= ultimateSize {
+		return Outcome{Finished: true, WinnerID: playerID}
+	}
+	for _, row := range meta {
+		for _, cell := range row {
+			if cell == "" {
+				return Outcome{}
+			}
+		}
+	}
+	return Outcome{Finished: true}
+}
+
+// MetaBoard returns the state of each local board, indexed like the board:
+// the symbol of the player who won it, LocalBoardDrawn, or empty while it
+// is undecided.
+func (UltimateRules) MetaBoard(g *Game) [][]string {
+	meta := make([][]string, ultimateSize)
+	for row := range meta {
+		meta[row] = make([]string, ultimateSize)
+		for col := range meta[row] {
+			meta[row][col] = localBoardStatus(g, Position{Row: row, Col: col})
+		}
+	}
+	return meta
+}
+
+// ForcedBoard returns the meta-board position of the local board the next
+// move must be played in, or false if any undecided local board may be
+// played.
+func (UltimateRules) ForcedBoard(g *Game) (Position, bool) {
+	if len(g.Moves) == 0 {
+		return Position{}, false
+	}
+	last := g.Moves[len(g.Moves)-1].Position
+	target := Position{Row: last.Row % ultimateSize, Col: last.Col % ultimateSize}
+	if localBoardStatus(g, target) != "" {
+		return Position{}, false
+	}
+	return target, true
+}
+
+// localBoardOf returns the meta-board position of the local board pos is in.
+func localBoardOf(pos Position) Position {
+	return Position{Row: pos.Row / ultimateSize, Col: pos.Col / ultimateSize}
+}
+
+// localBoardStatus returns the symbol that has three in a row on the local
+// board at meta-board position local, LocalBoardDrawn if it is full, or
+// empty.
+func localBoardStatus(g *Game, local Position) string {
+	grid := make([][]string, ultimateSize)
+	full := true
+	for row := range grid {
+		start := local.Col * ultimateSize
+		grid[row] = g.Board[local.Row*ultimateSize+row][start : start+ultimateSize]
+		for _, cell := range grid[row] {
+			if cell == "" {
+				full = false
+			}
+		}
+	}
+	for row := range grid {
+		for col, cell := range grid[row] {
+			if cell != "" && longestLineIn(grid, Position{Row: row, Col: col}, cell) >= ultimateSize {
+				return cell
+			}
+		}
+	}
+	if full {
+		return LocalBoardDrawn
+	}
+	return ""
+}
+
+// longestLineIn returns the length of the longest line of symbol through pos
+// on a square grid.
+func longestLineIn(grid [][]string, pos Position, symbol string) int {
+	size := len(grid)
+	longest := 0
+	for _, dir := range directions {
+		count := 1
+		for _, sign := range []int{1, -1} {
+			r, c := pos.Row+sign*dir[0], pos.Col+sign*dir[1]
+			for (Position{Row: r, Col: c}).IsValid(size, size) && grid[r][c] == symbol {
+				count++
+				r += sign * dir[0]
+				c += sign * dir[1]
+			}
+		}
+		longest = max(longest, count)
+	}
+	return longest
+}
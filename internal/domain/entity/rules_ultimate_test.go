@@ -0,0 +1,103 @@
+// internal/domain/entity/rules_ultimate_test.go
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ultimateGame returns a started ultimate game with player1 to move.
+func ultimateGame(t *testing.T) *Game {
+	t.Helper()
+	game := NewGameWithSettings("player1", GameSettings{BoardSize: 3, Variant: VariantUltimate})
+	require.NoError(t, game.JoinPlayer("player2"))
+	return game
+}
+
+// setLocalBoard fills the local board at meta-board position local from
+// three rows of "X", "O" or "." for empty.
+func setLocalBoard(g *Game, local Position, rows ...string) {
+	for r, row := range rows {
+		for c, cell := range row {
+			symbol := string(cell)
+			if symbol == "." {
+				symbol = ""
+			}
+			g.Board[local.Row*3+r][local.Col*3+c] = symbol
+		}
+	}
+}
+
+func TestUltimateRules_ForcedBoard(t *testing.T) {
+	game := ultimateGame(t)
+	assert.Equal(t, 9, game.BoardWidth)
+	assert.Equal(t, 9, game.BoardHeight)
+	assert.Equal(t, 3, game.WinningLength)
+
+	rules := UltimateRules{}
+	_, forced := rules.ForcedBoard(game)
+	assert.False(t, forced)
+
+	// The middle-left cell of a local board sends the opponent to the
+	// middle-left local board
+	require.NoError(t, game.MakeMove("player1", Position{4, 3}))
+	board, forced := rules.ForcedBoard(game)
+	require.True(t, forced)
+	assert.Equal(t, Position{1, 0}, board)
+
+	assert.Equal(t, ErrWrongLocalBoard, game.MakeMove("player2", Position{0, 0}))
+	require.NoError(t, game.MakeMove("player2", Position{5, 2}))
+	board, _ = rules.ForcedBoard(game)
+	assert.Equal(t, Position{2, 2}, board)
+}
+
+func TestUltimateRules_DecidedLocalBoards(t *testing.T) {
+	game := ultimateGame(t)
+	setLocalBoard(game, Position{0, 0}, "XXX", "OO.", "...")
+	assert.Equal(t, "X", UltimateRules{}.MetaBoard(game)[0][0])
+
+	assert.Equal(t, ErrLocalBoardDecided, game.MakeMove("player1", Position{1, 2}))
+
+	// Being sent to a decided local board frees the choice
+	require.NoError(t, game.MakeMove("player1", Position{3, 3}))
+	_, forced := UltimateRules{}.ForcedBoard(game)
+	assert.False(t, forced)
+	require.NoError(t, game.MakeMove("player2", Position{8, 8}))
+}
+
+func TestUltimateRules_Outcome(t *testing.T) {
+	t.Run("meta-board line wins", func(t *testing.T) {
+		game := ultimateGame(t)
+		setLocalBoard(game, Position{0, 0}, "XXX", "OO.", "...")
+		setLocalBoard(game, Position{0, 1}, "X..", "OX.", "O.X")
+		setLocalBoard(game, Position{0, 2}, "XX.", "OO.", "...")
+
+		require.NoError(t, game.MakeMove("player1", Position{0, 8}))
+		assert.Equal(t, "X", UltimateRules{}.MetaBoard(game)[0][2])
+		assert.Equal(t, StatusFinishedWin, game.Status)
+		assert.Equal(t, "player1", game.WinnerID)
+	})
+
+	t.Run("every local board decided is a draw", func(t *testing.T) {
+		game := ultimateGame(t)
+		won := map[string][]string{
+			"X": {"XXX", "OO.", "..."},
+			"O": {"OOO", "XX.", "X.."},
+		}
+		meta := []string{"XOX", "OXX", "OX."}
+		for r, row := range meta {
+			for c, symbol := range row {
+				if symbol != '.' {
+					setLocalBoard(game, Position{r, c}, won[string(symbol)]...)
+				}
+			}
+		}
+		setLocalBoard(game, Position{2, 2}, "XOX", "XOO", "OX.")
+
+		require.NoError(t, game.MakeMove("player1", Position{8, 8}))
+		assert.Equal(t, LocalBoardDrawn, UltimateRules{}.MetaBoard(game)[2][2])
+		assert.Equal(t, StatusFinishedDraw, game.Status)
+	})
+}
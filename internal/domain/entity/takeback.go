@@ -0,0 +1,100 @@
+// internal/domain/entity/takeback.go
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrTakebacksNotAllowed = errors.New("takebacks are not allowed in ranked games")
+	ErrTakebackLimit       = errors.New("takeback limit reached")
+	ErrTakebackRequested   = errors.New("takeback already requested")
+	ErrNoTakebackRequest   = errors.New("no takeback request to answer")
+	ErrNothingToTakeBack   = errors.New("no move to take back")
+)
+
+// Takeback records a granted request to retract a move.
+type Takeback struct {
+	RequestedBy string
+	// Moves are the plies removed from the history, most recent first.
+	Moves     []Move
+	GrantedAt time.Time
+}
+
+// RequestTakeback asks the opponent to let playerID retract their last
+// move. At most limit takebacks are granted per game, whoever asks for
+// them, and only two-player games allow takebacks.
+func (g *Game) RequestTakeback(playerID string, limit int) error {
+	if !g.IsPlayerInGame(playerID) {
+		return ErrPlayerNotInGame
+	}
+	if g.Seats > 2 {
+		return ErrTwoPlayerOnly
+	}
+	if g.Status != StatusInProgress {
+		if g.IsFinished() {
+			return ErrGameFinished
+		}
+		return ErrGameNotStarted
+	}
+	if g.Ranked {
+		return ErrTakebacksNotAllowed
+	}
+	if g.TakebackRequestedBy != "" {
+		return ErrTakebackRequested
+	}
+	if g.lastMoveIndex(playerID) < 0 {
+		return ErrNothingToTakeBack
+	}
+	if len(g.Takebacks) >= limit {
+		return ErrTakebackLimit
+	}
+
+	g.TakebackRequestedBy = playerID
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+// RespondTakeback answers the opponent's open request. Accepting removes
+// the requester's last move from the board, along with the reply to it if
+// there is one, gives the turn back to the requester and withdraws any
+// draw offer, which was made for the position being undone.
+func (g *Game) RespondTakeback(playerID string, accept bool) error {
+	if !g.IsPlayerInGame(playerID) {
+		return ErrPlayerNotInGame
+	}
+	requester := g.TakebackRequestedBy
+	if g.Status != StatusInProgress || requester == "" || requester == playerID {
+		return ErrNoTakebackRequest
+	}
+
+	g.TakebackRequestedBy = ""
+	g.UpdatedAt = time.Now()
+	if !accept {
+		return nil
+	}
+
+	last := g.lastMoveIndex(requester)
+	taken := make([]Move, 0, len(g.Moves)-last)
+	for i := len(g.Moves) - 1; i >= last; i-- {
+		move := g.Moves[i]
+		g.Board[move.Position.Row][move.Position.Col] = ""
+		taken = append(taken, move)
+	}
+	g.Moves = g.Moves[:last]
+	g.CurrentPlayer = requester
+	g.DrawOfferedBy = ""
+	g.Takebacks = append(g.Takebacks, Takeback{RequestedBy: requester, Moves: taken, GrantedAt: g.UpdatedAt})
+	return nil
+}
+
+// lastMoveIndex returns the index in Moves of playerID's latest move, or -1.
+func (g *Game) lastMoveIndex(playerID string) int {
+	for i := len(g.Moves) - 1; i >= 0; i-- {
+		if g.Moves[i].PlayerID == playerID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,123 @@
+// internal/domain/entity/takeback_test.go
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func casualGame(t *testing.T) *Game {
+	t.Helper()
+	game := NewPrivateGame("player1", GameSettings{BoardSize: 5, WinningLength: 4}, "player2")
+	require.NoError(t, game.JoinPlayer("player2"))
+	return game
+}
+
+func TestGame_TakebackNotAllowed(t *testing.T) {
+	ranked := NewGame("player1", 3, 3)
+	require.NoError(t, ranked.JoinPlayer("player2"))
+	require.NoError(t, ranked.MakeMove("player1", Position{0, 0}))
+	assert.Equal(t, ErrTakebacksNotAllowed, ranked.RequestTakeback("player1", 3))
+
+	game := casualGame(t)
+	assert.Equal(t, ErrPlayerNotInGame, game.RequestTakeback("player3", 3))
+	assert.Equal(t, ErrNothingToTakeBack, game.RequestTakeback("player1", 3))
+	assert.Equal(t, ErrNoTakebackRequest, game.RespondTakeback("player2", true))
+
+	require.NoError(t, game.MakeMove("player1", Position{0, 0}))
+	require.NoError(t, game.RequestTakeback("player1", 3))
+	assert.Equal(t, ErrTakebackRequested, game.RequestTakeback("player1", 3))
+	assert.Equal(t, ErrNoTakebackRequest, game.RespondTakeback("player1", true))
+
+	// Declining keeps the move
+	require.NoError(t, game.RespondTakeback("player2", false))
+	assert.Empty(t, game.TakebackRequestedBy)
+	assert.Equal(t, "X", game.Board[0][0])
+	assert.Empty(t, game.Takebacks)
+}
+
+func TestGame_RankedSetting(t *testing.T) {
+	ranked, casual := true, false
+	assert.True(t, NewGame("player1", 3, 3).Ranked)
+	assert.False(t, NewPrivateGame("player1", GameSettings{BoardSize: 3}, "").Ranked)
+	assert.False(t, NewGameWithSettings("player1", GameSettings{BoardSize: 3, Ranked: &casual}).Ranked)
+	assert.True(t, NewPrivateGame("player1", GameSettings{BoardSize: 3, Ranked: &ranked}, "").Ranked)
+
+	// A casual public game allows takebacks, and its rematch stays casual
+	game := NewGameWithSettings("player1", GameSettings{BoardSize: 3, Ranked: &casual})
+	require.NoError(t, game.JoinPlayer("player2"))
+	require.NoError(t, game.MakeMove("player1", Position{0, 0}))
+	require.NoError(t, game.RequestTakeback("player1", 3))
+	assert.False(t, NewGameWithSettings("player2", game.Settings()).Ranked)
+}
+
+func TestGame_TakebackOnePly(t *testing.T) {
+	game := casualGame(t)
+	require.NoError(t, game.MakeMove("player1", Position{0, 0}))
+	require.NoError(t, game.MakeMove("player2", Position{1, 1}))
+
+	require.NoError(t, game.RequestTakeback("player2", 3))
+	require.NoError(t, game.RespondTakeback("player1", true))
+
+	assert.Empty(t, game.Board[1][1])
+	assert.Equal(t, "X", game.Board[0][0])
+	assert.Equal(t, "player2", game.CurrentPlayer)
+	require.Len(t, game.Moves, 1)
+	require.Len(t, game.Takebacks, 1)
+	assert.Equal(t, "player2", game.Takebacks[0].RequestedBy)
+	require.Len(t, game.Takebacks[0].Moves, 1)
+	assert.Equal(t, Position{1, 1}, game.Takebacks[0].Moves[0].Position)
+}
+
+func TestGame_TakebackTwoPlies(t *testing.T) {
+	game := casualGame(t)
+	require.NoError(t, game.MakeMove("player1", Position{0, 0}))
+	require.NoError(t, game.RequestTakeback("player1", 3))
+	// The opponent replies before answering
+	require.NoError(t, game.MakeMove("player2", Position{1, 1}))
+	require.NoError(t, game.RespondTakeback("player2", true))
+
+	assert.Empty(t, game.Board[0][0])
+	assert.Empty(t, game.Board[1][1])
+	assert.Empty(t, game.Moves)
+	assert.Equal(t, "player1", game.CurrentPlayer)
+	require.Len(t, game.Takebacks, 1)
+	assert.Equal(t, []Position{{1, 1}, {0, 0}}, []Position{game.Takebacks[0].Moves[0].Position, game.Takebacks[0].Moves[1].Position})
+
+	// The clone does not share history with the original
+	clone := game.Clone()
+	clone.Takebacks[0].Moves[0].PlayerID = "changed"
+	assert.Equal(t, "player2", game.Takebacks[0].Moves[0].PlayerID)
+}
+
+func TestGame_TakebackLimit(t *testing.T) {
+	game := casualGame(t)
+	require.NoError(t, game.MakeMove("player1", Position{0, 0}))
+	require.NoError(t, game.RequestTakeback("player1", 1))
+	require.NoError(t, game.RespondTakeback("player2", true))
+
+	require.NoError(t, game.MakeMove("player1", Position{0, 1}))
+	assert.Equal(t, ErrTakebackLimit, game.RequestTakeback("player1", 1))
+
+	// The limit is shared by both players
+	require.NoError(t, game.MakeMove("player2", Position{1, 1}))
+	assert.Equal(t, ErrTakebackLimit, game.RequestTakeback("player2", 1))
+}
+
+func TestGame_TakebackWithdrawsDrawOffer(t *testing.T) {
+	game := casualGame(t)
+	require.NoError(t, game.MakeMove("player1", Position{0, 0}))
+	require.NoError(t, game.OfferDraw("player1"))
+	require.NoError(t, game.RequestTakeback("player1", 3))
+
+	// Declining leaves the offer open
+	require.NoError(t, game.RespondTakeback("player2", false))
+	assert.Equal(t, "player1", game.DrawOfferedBy)
+
+	require.NoError(t, game.RequestTakeback("player1", 3))
+	require.NoError(t, game.RespondTakeback("player2", true))
+	assert.Empty(t, game.DrawOfferedBy)
+	assert.Equal(t, ErrNoDrawOffer, game.AcceptDraw("player2"))
+}
@@ -4,15 +4,27 @@ package entity
 import "errors"
 
 var (
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrInvalidAdjustment = errors.New("adjustment would make stats negative")
 )
 
+// UserStats are a player's results across all games, with a breakdown per
+// game variant in Variants.
 type UserStats struct {
 	UserID     string
 	Wins       int
 	Losses     int
 	Draws      int
 	TotalGames int
+	Variants   map[string]VariantStats
+}
+
+// VariantStats are a player's results in games of one variant.
+type VariantStats struct {
+	Wins       int
+	Losses     int
+	Draws      int
+	TotalGames int
 }
 
 func NewUserStats(userID string) *UserStats {
@@ -21,17 +33,59 @@ func NewUserStats(userID string) *UserStats {
 	}
 }
 
-func (s *UserStats) RecordWin() {
+// Clone returns a deep copy of the stats.
+func (s *UserStats) Clone() *UserStats {
+	statsCopy := *s
+	if s.Variants != nil {
+		statsCopy.Variants = make(map[string]VariantStats, len(s.Variants))
+		for variant, stats := range s.Variants {
+			statsCopy.Variants[variant] = stats
+		}
+	}
+	return &statsCopy
+}
+
+func (s *UserStats) RecordWin(variant string) {
 	s.Wins++
 	s.TotalGames++
+	s.recordVariant(variant, func(v *VariantStats) { v.Wins++ })
 }
 
-func (s *UserStats) RecordLoss() {
+func (s *UserStats) RecordLoss(variant string) {
 	s.Losses++
 	s.TotalGames++
+	s.recordVariant(variant, func(v *VariantStats) { v.Losses++ })
 }
 
-func (s *UserStats) RecordDraw() {
+func (s *UserStats) RecordDraw(variant string) {
 	s.Draws++
 	s.TotalGames++
+	s.recordVariant(variant, func(v *VariantStats) { v.Draws++ })
+}
+
+func (s *UserStats) recordVariant(variant string, record func(*VariantStats)) {
+	if variant == "" {
+		variant = VariantStandard
+	}
+	if s.Variants == nil {
+		s.Variants = make(map[string]VariantStats)
+	}
+	stats := s.Variants[variant]
+	record(&stats)
+	stats.TotalGames++
+	s.Variants[variant] = stats
+}
+
+// Adjust applies operator corrections to the counters, keeping TotalGames
+// consistent. It fails without changing anything if a counter would become
+// negative. The per-variant breakdown is left as recorded.
+func (s *UserStats) Adjust(wins, losses, draws int) error {
+	if s.Wins+wins < 0 || s.Losses+losses < 0 || s.Draws+draws < 0 {
+		return ErrInvalidAdjustment
+	}
+	s.Wins += wins
+	s.Losses += losses
+	s.Draws += draws
+	s.TotalGames = s.Wins + s.Losses + s.Draws
+	return nil
 }
@@ -0,0 +1,34 @@
+// internal/domain/port/admin_service.go
+package port
+
+import (
+	"context"
+	"time"
+
+	"tictactoe/internal/domain/entity"
+)
+
+// AdminService lets operators inspect and correct live state.
+type AdminService interface {
+	ListGames(ctx context.Context, filter GameFilter) ([]*entity.Game, error)
+	// ForceEndGame ends an unfinished game, abandoning it unless a winner or
+	// a draw is given. Declared results are recorded in the players' stats.
+	ForceEndGame(ctx context.Context, gameID, winnerID string, draw bool) (*entity.Game, error)
+	DeleteGame(ctx context.Context, gameID string) error
+	ResetUserStats(ctx context.Context, userID string) (*entity.UserStats, error)
+	AdjustUserStats(ctx context.Context, userID string, wins, losses, draws int) (*entity.UserStats, error)
+	ServerStats(ctx context.Context) (*ServerStats, error)
+}
+
+// ServerStats is a point-in-time summary of the server.
+type ServerStats struct {
+	Games            int64
+	GamesByStatus    map[entity.GameStatus]int64
+	ConnectedStreams int
+	Uptime           time.Duration
+}
+
+// StreamCounter reports how many clients are following games live.
+type StreamCounter interface {
+	ActiveStreams() int
+}
@@ -0,0 +1,30 @@
+// internal/domain/port/game_events.go
+package port
+
+import (
+	"context"
+
+	"tictactoe/internal/domain/entity"
+)
+
+// GameEventPublisher is notified whenever a game's state changes, so that
+// live clients can be pushed the new board. Implementations must not block.
+// Games are published after they are saved, but concurrent updates may be
+// published out of order; Game.Version tells which snapshot is newer.
+type GameEventPublisher interface {
+	PublishGameUpdated(ctx context.Context, game *entity.Game)
+}
+
+// SpectatorTracker counts the users watching each game. Spectators are kept
+// out of the game itself, so watching never saves it and never competes
+// with the players' updates.
+type SpectatorTracker interface {
+	// AddSpectator records an open stream of userID watching the game.
+	AddSpectator(gameID, userID string)
+	// RemoveSpectator records that one of userID's streams closed.
+	RemoveSpectator(gameID, userID string)
+	// ClearSpectators forgets everyone watching the game.
+	ClearSpectators(gameID string)
+	// SpectatorCount returns the number of distinct users watching the game.
+	SpectatorCount(gameID string) int
+}
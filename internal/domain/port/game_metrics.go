@@ -0,0 +1,17 @@
+// internal/domain/port/game_metrics.go
+package port
+
+import (
+	"context"
+
+	"tictactoe/internal/domain/entity"
+)
+
+// GameMetrics receives game lifecycle events worth measuring.
+// Implementations must be safe for concurrent use.
+type GameMetrics interface {
+	// GameMatched is called once a pending game fills its last seat.
+	GameMatched(ctx context.Context, game *entity.Game)
+	// GameFinished is called once a game reaches a terminal status.
+	GameFinished(ctx context.Context, game *entity.Game)
+}
@@ -1,12 +1,86 @@
 // internal/domain/port/game_repository.go
 package port
 
-import "tictactoe/internal/domain/entity"
+import (
+	"context"
+	"errors"
+	"time"
+
+	"tictactoe/internal/domain/entity"
+)
+
+// ErrConcurrentUpdate is returned by Save when the game was saved by someone
+// else since it was loaded. Callers reload the game and try again.
+var ErrConcurrentUpdate = errors.New("game was changed concurrently")
+
+// GameFilter selects games for FindGames. Zero-valued fields match any game.
+// BoardSize matches square boards of that size.
+type GameFilter struct {
+	Status        *entity.GameStatus
+	PlayerID      string
+	BoardSize     int
+	BoardWidth    int
+	BoardHeight   int
+	WinningLength int
+	// CreatedAfter and CreatedBefore bound the creation time.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// Limit caps the number of results; 0 means no limit.
+	Limit int
+}
+
+// PendingGameFilter selects games for FindPendingGames. Zero-valued fields
+// match any pending game.
+type PendingGameFilter struct {
+	// BoardSize matches square boards of that size; BoardWidth and
+	// BoardHeight match each dimension.
+	BoardSize     int
+	BoardWidth    int
+	BoardHeight   int
+	WinningLength int
+	Variant       string
+	Seats         int
+	CreatorID     string
+	// ExcludeCreatorID drops games created by this user, typically the caller.
+	ExcludeCreatorID string
+	// ExcludeCustomSetup drops games with obstacles or handicap stones.
+	ExcludeCustomSetup bool
+	// Ranked, if set, matches only ranked or only casual games.
+	Ranked *bool
+	// CreatedAfter bounds the creation time.
+	CreatedAfter time.Time
+	// NewestFirst returns the games newest first instead of oldest first.
+	NewestFirst bool
+	// Offset skips that many matching games and Limit, if positive, caps
+	// how many are returned.
+	Offset int
+	Limit  int
+}
 
 type GameRepository interface {
-	Save(game *entity.Game) error
-	FindByID(id string) (*entity.Game, error)
-	FindPendingGames(boardSize, winningLength int) ([]*entity.Game, error)
-	Delete(id string) error
-	Count() int64
+	// Save stores the game. Saving a game that already exists fails with
+	// ErrConcurrentUpdate unless game.Version is the stored version; on
+	// success the version advances, in the caller's game too.
+	Save(ctx context.Context, game *entity.Game) error
+	FindByID(ctx context.Context, id string) (*entity.Game, error)
+	// FindByJoinCode returns the private game with the given join code, or
+	// entity.ErrGameNotFound.
+	FindByJoinCode(ctx context.Context, code string) (*entity.Game, error)
+	// FindPendingGames returns a page of the pending games matching filter,
+	// oldest first unless filter.NewestFirst, and how many match in total.
+	// Implementations must keep pending games indexed in creation order, so
+	// a page is read without scanning or sorting every match.
+	FindPendingGames(ctx context.Context, filter PendingGameFilter) ([]*entity.Game, int, error)
+	// FindGamesByPlayer returns every game the player takes part in, in no
+	// particular order. Implementations must index games by player.
+	FindGamesByPlayer(ctx context.Context, playerID string) ([]*entity.Game, error)
+	// FindGames returns the games matching filter, newest first.
+	FindGames(ctx context.Context, filter GameFilter) ([]*entity.Game, error)
+	Delete(ctx context.Context, id string) error
+	// Count and CountByStatus have no error to report and return 0 once ctx
+	// is done; callers that must tell that apart check ctx themselves.
+	Count(ctx context.Context) int64
+	CountByStatus(ctx context.Context, status entity.GameStatus) int64
+	// Ping reports whether the underlying store is reachable and usable.
+	Ping(ctx context.Context) error
 }
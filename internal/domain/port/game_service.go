@@ -2,14 +2,89 @@
 package port
 
 import (
+	"context"
+	"errors"
+
 	"tictactoe/internal/domain/entity"
 )
 
+// ErrInvalidPageToken is returned when a page token was not issued by the
+// paginated call it is passed to.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// GamePage is one page of a paginated game listing.
+type GamePage struct {
+	Games []*entity.Game
+	// NextPageToken fetches the following page; empty on the last page.
+	NextPageToken string
+	// TotalSize is the number of games across all pages.
+	TotalSize int
+}
+
+// PendingGameOrder selects the order of SearchPendingGames results.
+type PendingGameOrder int
+
+const (
+	// OrderOldestFirst lists the longest-waiting games first.
+	OrderOldestFirst PendingGameOrder = iota
+	OrderNewestFirst
+	// OrderCreatorWins lists games whose creators have the most wins first.
+	OrderCreatorWins
+)
+
+// PendingGameQuery is a SearchPendingGames request.
+type PendingGameQuery struct {
+	Filter    PendingGameFilter
+	Order     PendingGameOrder
+	PageToken string
+	PageSize  int
+}
+
 type GameService interface {
-	StartGame(userID string, boardSize, winningLength int) (*entity.Game, error)
-	SearchPendingGames(boardSize, winningLength int) ([]*entity.Game, error)
-	JoinGame(userID, gameID string) (*entity.Game, error)
-	MakeMove(userID, gameID string, row, col int) (*entity.Game, error)
-	GetGame(gameID, userID string) (*entity.Game, error)
-	GetUserStats(userID string) (*entity.UserStats, error)
+	// StartGame joins the longest-waiting public game with the same settings,
+	// or creates one. It fails with entity.ErrUnknownVariant if the variant
+	// is not registered.
+	StartGame(ctx context.Context, userID string, settings entity.GameSettings) (*entity.Game, error)
+	// StartPrivateGame creates a game hidden from search and matchmaking that
+	// only invitedUserID (optional) or holders of its join code may join.
+	StartPrivateGame(ctx context.Context, userID string, settings entity.GameSettings, invitedUserID string) (*entity.Game, error)
+	SearchPendingGames(ctx context.Context, query PendingGameQuery) (*GamePage, error)
+	JoinGame(ctx context.Context, userID, gameID string) (*entity.Game, error)
+	// JoinGameByCode joins the private game with the given join code.
+	JoinGameByCode(ctx context.Context, userID, joinCode string) (*entity.Game, error)
+	MakeMove(ctx context.Context, userID, gameID string, row, col int) (*entity.Game, error)
+	GetGame(ctx context.Context, gameID, userID string) (*entity.Game, error)
+	GetUserStats(ctx context.Context, userID string) (*entity.UserStats, error)
+	// ListUserGames lists the user's games with unfinished games first (those
+	// awaiting the user's move leading), then finished games newest first.
+	// An empty statuses slice matches every status.
+	ListUserGames(ctx context.Context, userID string, statuses []entity.GameStatus, pageToken string, pageSize int) (*GamePage, error)
+	// SpectateGame returns the game for userID to watch and, for non-players,
+	// counts them as a spectator until StopSpectating is called.
+	SpectateGame(ctx context.Context, gameID, userID string) (*entity.Game, error)
+	StopSpectating(ctx context.Context, gameID, userID string) error
+	// SetSpectatorsAllowed lets the game's creator open or close it to
+	// spectators.
+	SetSpectatorsAllowed(ctx context.Context, userID, gameID string, allowed bool) (*entity.Game, error)
+	// ListLiveGames lists public in-progress games open to spectators, the
+	// most watched first.
+	ListLiveGames(ctx context.Context, pageToken string, pageSize int) (*GamePage, error)
+	// OfferRematch offers the opponent a rematch of a finished game. The
+	// offer lapses after the configured timeout.
+	OfferRematch(ctx context.Context, userID, gameID string) (*entity.Game, error)
+	// AcceptRematch accepts the opponent's offer and returns the new game.
+	AcceptRematch(ctx context.Context, userID, gameID string) (*entity.Game, error)
+	DeclineRematch(ctx context.Context, userID, gameID string) (*entity.Game, error)
+	// OfferDraw offers the opponent a draw in an in-progress game. Moving
+	// instead of answering declines it.
+	OfferDraw(ctx context.Context, userID, gameID string) (*entity.Game, error)
+	// AcceptDraw accepts the opponent's offer, finishing the game as a draw.
+	AcceptDraw(ctx context.Context, userID, gameID string) (*entity.Game, error)
+	DeclineDraw(ctx context.Context, userID, gameID string) (*entity.Game, error)
+	// RequestTakeback asks the opponent to let the user retract their last
+	// move. Takebacks are only allowed in unranked games, up to the
+	// configured number per game.
+	RequestTakeback(ctx context.Context, userID, gameID string) (*entity.Game, error)
+	// RespondTakeback accepts or declines the opponent's request.
+	RespondTakeback(ctx context.Context, userID, gameID string, accept bool) (*entity.Game, error)
 }
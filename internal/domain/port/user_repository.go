@@ -1,10 +1,16 @@
 // internal/domain/port/user_repository.go
 package port
 
-import "tictactoe/internal/domain/entity"
+import (
+	"context"
+
+	"tictactoe/internal/domain/entity"
+)
 
 type UserRepository interface {
-	SaveStats(stats *entity.UserStats) error
-	FindStatsByUserID(userID string) (*entity.UserStats, error)
-	CreateUserIfNotExists(userID string) error
+	SaveStats(ctx context.Context, stats *entity.UserStats) error
+	FindStatsByUserID(ctx context.Context, userID string) (*entity.UserStats, error)
+	CreateUserIfNotExists(ctx context.Context, userID string) error
+	// Ping reports whether the underlying store is reachable and usable.
+	Ping(ctx context.Context) error
 }
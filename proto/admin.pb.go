@@ -0,0 +1,808 @@
+// proto/admin.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: proto/admin.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *GameStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=tictactoe.GameStatus,oneof" json:"status,omitempty"`      // optional filter
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                   // optional filter
+	BoardSize     int32                  `protobuf:"varint,3,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"`               // optional filter, matches square boards of this size
+	WinningLength int32                  `protobuf:"varint,4,opt,name=winning_length,json=winningLength,proto3" json:"winning_length,omitempty"`   // optional filter
+	MinAgeSeconds int64                  `protobuf:"varint,5,opt,name=min_age_seconds,json=minAgeSeconds,proto3" json:"min_age_seconds,omitempty"` // optional, only games at least this old
+	MaxAgeSeconds int64                  `protobuf:"varint,6,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"` // optional, only games at most this old
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                        // optional, 0 returns every match
+	BoardWidth    int32                  `protobuf:"varint,8,opt,name=board_width,json=boardWidth,proto3" json:"board_width,omitempty"`            // optional filter
+	BoardHeight   int32                  `protobuf:"varint,9,opt,name=board_height,json=boardHeight,proto3" json:"board_height,omitempty"`         // optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGamesRequest) Reset() {
+	*x = ListGamesRequest{}
+	mi := &file_proto_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesRequest) ProtoMessage() {}
+
+func (x *ListGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListGamesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListGamesRequest) GetStatus() GameStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return GameStatus_PENDING
+}
+
+func (x *ListGamesRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetBoardSize() int32 {
+	if x != nil {
+		return x.BoardSize
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetWinningLength() int32 {
+	if x != nil {
+		return x.WinningLength
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetMinAgeSeconds() int64 {
+	if x != nil {
+		return x.MinAgeSeconds
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetBoardWidth() int32 {
+	if x != nil {
+		return x.BoardWidth
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetBoardHeight() int32 {
+	if x != nil {
+		return x.BoardHeight
+	}
+	return 0
+}
+
+type ListGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGamesResponse) Reset() {
+	*x = ListGamesResponse{}
+	mi := &file_proto_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesResponse) ProtoMessage() {}
+
+func (x *ListGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListGamesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListGamesResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type ForceEndGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	WinnerId      string                 `protobuf:"bytes,2,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"` // optional, declares a winner
+	Draw          bool                   `protobuf:"varint,3,opt,name=draw,proto3" json:"draw,omitempty"`                        // optional, declares a draw; abandons the game if neither is set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceEndGameRequest) Reset() {
+	*x = ForceEndGameRequest{}
+	mi := &file_proto_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceEndGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceEndGameRequest) ProtoMessage() {}
+
+func (x *ForceEndGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceEndGameRequest.ProtoReflect.Descriptor instead.
+func (*ForceEndGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ForceEndGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ForceEndGameRequest) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *ForceEndGameRequest) GetDraw() bool {
+	if x != nil {
+		return x.Draw
+	}
+	return false
+}
+
+type ForceEndGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceEndGameResponse) Reset() {
+	*x = ForceEndGameResponse{}
+	mi := &file_proto_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceEndGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceEndGameResponse) ProtoMessage() {}
+
+func (x *ForceEndGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceEndGameResponse.ProtoReflect.Descriptor instead.
+func (*ForceEndGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ForceEndGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type DeleteGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameRequest) Reset() {
+	*x = DeleteGameRequest{}
+	mi := &file_proto_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameRequest) ProtoMessage() {}
+
+func (x *DeleteGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type DeleteGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameResponse) Reset() {
+	*x = DeleteGameResponse{}
+	mi := &file_proto_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameResponse) ProtoMessage() {}
+
+func (x *DeleteGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{5}
+}
+
+type ResetUserStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserStatsRequest) Reset() {
+	*x = ResetUserStatsRequest{}
+	mi := &file_proto_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserStatsRequest) ProtoMessage() {}
+
+func (x *ResetUserStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*ResetUserStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResetUserStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResetUserStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *UserStats             `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserStatsResponse) Reset() {
+	*x = ResetUserStatsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserStatsResponse) ProtoMessage() {}
+
+func (x *ResetUserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserStatsResponse.ProtoReflect.Descriptor instead.
+func (*ResetUserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResetUserStatsResponse) GetStats() *UserStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type AdjustUserStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WinsDelta     int32                  `protobuf:"varint,2,opt,name=wins_delta,json=winsDelta,proto3" json:"wins_delta,omitempty"`
+	LossesDelta   int32                  `protobuf:"varint,3,opt,name=losses_delta,json=lossesDelta,proto3" json:"losses_delta,omitempty"`
+	DrawsDelta    int32                  `protobuf:"varint,4,opt,name=draws_delta,json=drawsDelta,proto3" json:"draws_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustUserStatsRequest) Reset() {
+	*x = AdjustUserStatsRequest{}
+	mi := &file_proto_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustUserStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustUserStatsRequest) ProtoMessage() {}
+
+func (x *AdjustUserStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*AdjustUserStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AdjustUserStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdjustUserStatsRequest) GetWinsDelta() int32 {
+	if x != nil {
+		return x.WinsDelta
+	}
+	return 0
+}
+
+func (x *AdjustUserStatsRequest) GetLossesDelta() int32 {
+	if x != nil {
+		return x.LossesDelta
+	}
+	return 0
+}
+
+func (x *AdjustUserStatsRequest) GetDrawsDelta() int32 {
+	if x != nil {
+		return x.DrawsDelta
+	}
+	return 0
+}
+
+type AdjustUserStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *UserStats             `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustUserStatsResponse) Reset() {
+	*x = AdjustUserStatsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustUserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustUserStatsResponse) ProtoMessage() {}
+
+func (x *AdjustUserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustUserStatsResponse.ProtoReflect.Descriptor instead.
+func (*AdjustUserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AdjustUserStatsResponse) GetStats() *UserStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type StatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	mi := &file_proto_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{10}
+}
+
+type StatsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TotalGames       int64                  `protobuf:"varint,1,opt,name=total_games,json=totalGames,proto3" json:"total_games,omitempty"`
+	GamesByStatus    map[string]int64       `protobuf:"bytes,2,rep,name=games_by_status,json=gamesByStatus,proto3" json:"games_by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // keyed by GameStatus name
+	ConnectedStreams int64                  `protobuf:"varint,3,opt,name=connected_streams,json=connectedStreams,proto3" json:"connected_streams,omitempty"`                                                                    // live game subscriptions
+	UptimeSeconds    int64                  `protobuf:"varint,4,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StatsResponse) GetTotalGames() int64 {
+	if x != nil {
+		return x.TotalGames
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetGamesByStatus() map[string]int64 {
+	if x != nil {
+		return x.GamesByStatus
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetConnectedStreams() int64 {
+	if x != nil {
+		return x.ConnectedStreams
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+var File_proto_admin_proto protoreflect.FileDescriptor
+
+const file_proto_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/admin.proto\x12\ttictactoe\x1a\x15proto/tictactoe.proto\"\xde\x02\n" +
+	"\x10ListGamesRequest\x122\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x15.tictactoe.GameStatusH\x00R\x06status\x88\x01\x01\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x1d\n" +
+	"\n" +
+	"board_size\x18\x03 \x01(\x05R\tboardSize\x12%\n" +
+	"\x0ewinning_length\x18\x04 \x01(\x05R\rwinningLength\x12&\n" +
+	"\x0fmin_age_seconds\x18\x05 \x01(\x03R\rminAgeSeconds\x12&\n" +
+	"\x0fmax_age_seconds\x18\x06 \x01(\x03R\rmaxAgeSeconds\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vboard_width\x18\b \x01(\x05R\n" +
+	"boardWidth\x12!\n" +
+	"\fboard_height\x18\t \x01(\x05R\vboardHeightB\t\n" +
+	"\a_status\":\n" +
+	"\x11ListGamesResponse\x12%\n" +
+	"\x05games\x18\x01 \x03(\v2\x0f.tictactoe.GameR\x05games\"_\n" +
+	"\x13ForceEndGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\twinner_id\x18\x02 \x01(\tR\bwinnerId\x12\x12\n" +
+	"\x04draw\x18\x03 \x01(\bR\x04draw\";\n" +
+	"\x14ForceEndGameResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\",\n" +
+	"\x11DeleteGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"\x14\n" +
+	"\x12DeleteGameResponse\"0\n" +
+	"\x15ResetUserStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"D\n" +
+	"\x16ResetUserStatsResponse\x12*\n" +
+	"\x05stats\x18\x01 \x01(\v2\x14.tictactoe.UserStatsR\x05stats\"\x94\x01\n" +
+	"\x16AdjustUserStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"wins_delta\x18\x02 \x01(\x05R\twinsDelta\x12!\n" +
+	"\flosses_delta\x18\x03 \x01(\x05R\vlossesDelta\x12\x1f\n" +
+	"\vdraws_delta\x18\x04 \x01(\x05R\n" +
+	"drawsDelta\"E\n" +
+	"\x17AdjustUserStatsResponse\x12*\n" +
+	"\x05stats\x18\x01 \x01(\v2\x14.tictactoe.UserStatsR\x05stats\"\x0e\n" +
+	"\fStatsRequest\"\x9b\x02\n" +
+	"\rStatsResponse\x12\x1f\n" +
+	"\vtotal_games\x18\x01 \x01(\x03R\n" +
+	"totalGames\x12S\n" +
+	"\x0fgames_by_status\x18\x02 \x03(\v2+.tictactoe.StatsResponse.GamesByStatusEntryR\rgamesByStatus\x12+\n" +
+	"\x11connected_streams\x18\x03 \x01(\x03R\x10connectedStreams\x12%\n" +
+	"\x0euptime_seconds\x18\x04 \x01(\x03R\ruptimeSeconds\x1a@\n" +
+	"\x12GamesByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x012\xdf\x03\n" +
+	"\fAdminService\x12F\n" +
+	"\tListGames\x12\x1b.tictactoe.ListGamesRequest\x1a\x1c.tictactoe.ListGamesResponse\x12O\n" +
+	"\fForceEndGame\x12\x1e.tictactoe.ForceEndGameRequest\x1a\x1f.tictactoe.ForceEndGameResponse\x12I\n" +
+	"\n" +
+	"DeleteGame\x12\x1c.tictactoe.DeleteGameRequest\x1a\x1d.tictactoe.DeleteGameResponse\x12U\n" +
+	"\x0eResetUserStats\x12 .tictactoe.ResetUserStatsRequest\x1a!.tictactoe.ResetUserStatsResponse\x12X\n" +
+	"\x0fAdjustUserStats\x12!.tictactoe.AdjustUserStatsRequest\x1a\".tictactoe.AdjustUserStatsResponse\x12:\n" +
+	"\x05Stats\x12\x17.tictactoe.StatsRequest\x1a\x18.tictactoe.StatsResponseB\x11Z\x0ftictactoe/protob\x06proto3"
+
+var (
+	file_proto_admin_proto_rawDescOnce sync.Once
+	file_proto_admin_proto_rawDescData []byte
+)
+
+func file_proto_admin_proto_rawDescGZIP() []byte {
+	file_proto_admin_proto_rawDescOnce.Do(func() {
+		file_proto_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_admin_proto_rawDesc), len(file_proto_admin_proto_rawDesc)))
+	})
+	return file_proto_admin_proto_rawDescData
+}
+
+var file_proto_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_admin_proto_goTypes = []any{
+	(*ListGamesRequest)(nil),        // 0: tictactoe.ListGamesRequest
+	(*ListGamesResponse)(nil),       // 1: tictactoe.ListGamesResponse
+	(*ForceEndGameRequest)(nil),     // 2: tictactoe.ForceEndGameRequest
+	(*ForceEndGameResponse)(nil),    // 3: tictactoe.ForceEndGameResponse
+	(*DeleteGameRequest)(nil),       // 4: tictactoe.DeleteGameRequest
+	(*DeleteGameResponse)(nil),      // 5: tictactoe.DeleteGameResponse
+	(*ResetUserStatsRequest)(nil),   // 6: tictactoe.ResetUserStatsRequest
+	(*ResetUserStatsResponse)(nil),  // 7: tictactoe.ResetUserStatsResponse
+	(*AdjustUserStatsRequest)(nil),  // 8: tictactoe.AdjustUserStatsRequest
+	(*AdjustUserStatsResponse)(nil), // 9: tictactoe.AdjustUserStatsResponse
+	(*StatsRequest)(nil),            // 10: tictactoe.StatsRequest
+	(*StatsResponse)(nil),           // 11: tictactoe.StatsResponse
+	nil,                             // 12: tictactoe.StatsResponse.GamesByStatusEntry
+	(GameStatus)(0),                 // 13: tictactoe.GameStatus
+	(*Game)(nil),                    // 14: tictactoe.Game
+	(*UserStats)(nil),               // 15: tictactoe.UserStats
+}
+var file_proto_admin_proto_depIdxs = []int32{
+	13, // 0: tictactoe.ListGamesRequest.status:type_name -> tictactoe.GameStatus
+	14, // 1: tictactoe.ListGamesResponse.games:type_name -> tictactoe.Game
+	14, // 2: tictactoe.ForceEndGameResponse.game:type_name -> tictactoe.Game
+	15, // 3: tictactoe.ResetUserStatsResponse.stats:type_name -> tictactoe.UserStats
+	15, // 4: tictactoe.AdjustUserStatsResponse.stats:type_name -> tictactoe.UserStats
+	12, // 5: tictactoe.StatsResponse.games_by_status:type_name -> tictactoe.StatsResponse.GamesByStatusEntry
+	0,  // 6: tictactoe.AdminService.ListGames:input_type -> tictactoe.ListGamesRequest
+	2,  // 7: tictactoe.AdminService.ForceEndGame:input_type -> tictactoe.ForceEndGameRequest
+	4,  // 8: tictactoe.AdminService.DeleteGame:input_type -> tictactoe.DeleteGameRequest
+	6,  // 9: tictactoe.AdminService.ResetUserStats:input_type -> tictactoe.ResetUserStatsRequest
+	8,  // 10: tictactoe.AdminService.AdjustUserStats:input_type -> tictactoe.AdjustUserStatsRequest
+	10, // 11: tictactoe.AdminService.Stats:input_type -> tictactoe.StatsRequest
+	1,  // 12: tictactoe.AdminService.ListGames:output_type -> tictactoe.ListGamesResponse
+	3,  // 13: tictactoe.AdminService.ForceEndGame:output_type -> tictactoe.ForceEndGameResponse
+	5,  // 14: tictactoe.AdminService.DeleteGame:output_type -> tictactoe.DeleteGameResponse
+	7,  // 15: tictactoe.AdminService.ResetUserStats:output_type -> tictactoe.ResetUserStatsResponse
+	9,  // 16: tictactoe.AdminService.AdjustUserStats:output_type -> tictactoe.AdjustUserStatsResponse
+	11, // 17: tictactoe.AdminService.Stats:output_type -> tictactoe.StatsResponse
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_admin_proto_init() }
+func file_proto_admin_proto_init() {
+	if File_proto_admin_proto != nil {
+		return
+	}
+	file_proto_tictactoe_proto_init()
+	file_proto_admin_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_admin_proto_rawDesc), len(file_proto_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_admin_proto_goTypes,
+		DependencyIndexes: file_proto_admin_proto_depIdxs,
+		MessageInfos:      file_proto_admin_proto_msgTypes,
+	}.Build()
+	File_proto_admin_proto = out.File
+	file_proto_admin_proto_goTypes = nil
+	file_proto_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,10 @@
+// proto/openapi.go
+package proto
+
+import _ "embed"
+
+// OpenAPIDocument is the OpenAPI v2 description of the REST gateway,
+// generated from the HTTP annotations in tictactoe.proto.
+//
+//go:embed tictactoe.swagger.json
+var OpenAPIDocument []byte
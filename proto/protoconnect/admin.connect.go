@@ -0,0 +1,253 @@
+// proto/admin.proto
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/admin.proto
+
+package protoconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	proto "tictactoe/proto"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "tictactoe.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceListGamesProcedure is the fully-qualified name of the AdminService's ListGames RPC.
+	AdminServiceListGamesProcedure = "/tictactoe.AdminService/ListGames"
+	// AdminServiceForceEndGameProcedure is the fully-qualified name of the AdminService's ForceEndGame
+	// RPC.
+	AdminServiceForceEndGameProcedure = "/tictactoe.AdminService/ForceEndGame"
+	// AdminServiceDeleteGameProcedure is the fully-qualified name of the AdminService's DeleteGame RPC.
+	AdminServiceDeleteGameProcedure = "/tictactoe.AdminService/DeleteGame"
+	// AdminServiceResetUserStatsProcedure is the fully-qualified name of the AdminService's
+	// ResetUserStats RPC.
+	AdminServiceResetUserStatsProcedure = "/tictactoe.AdminService/ResetUserStats"
+	// AdminServiceAdjustUserStatsProcedure is the fully-qualified name of the AdminService's
+	// AdjustUserStats RPC.
+	AdminServiceAdjustUserStatsProcedure = "/tictactoe.AdminService/AdjustUserStats"
+	// AdminServiceStatsProcedure is the fully-qualified name of the AdminService's Stats RPC.
+	AdminServiceStatsProcedure = "/tictactoe.AdminService/Stats"
+)
+
+// AdminServiceClient is a client for the tictactoe.AdminService service.
+type AdminServiceClient interface {
+	ListGames(context.Context, *connect.Request[proto.ListGamesRequest]) (*connect.Response[proto.ListGamesResponse], error)
+	ForceEndGame(context.Context, *connect.Request[proto.ForceEndGameRequest]) (*connect.Response[proto.ForceEndGameResponse], error)
+	DeleteGame(context.Context, *connect.Request[proto.DeleteGameRequest]) (*connect.Response[proto.DeleteGameResponse], error)
+	ResetUserStats(context.Context, *connect.Request[proto.ResetUserStatsRequest]) (*connect.Response[proto.ResetUserStatsResponse], error)
+	AdjustUserStats(context.Context, *connect.Request[proto.AdjustUserStatsRequest]) (*connect.Response[proto.AdjustUserStatsResponse], error)
+	Stats(context.Context, *connect.Request[proto.StatsRequest]) (*connect.Response[proto.StatsResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the tictactoe.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := proto.File_proto_admin_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		listGames: connect.NewClient[proto.ListGamesRequest, proto.ListGamesResponse](
+			httpClient,
+			baseURL+AdminServiceListGamesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListGames")),
+			connect.WithClientOptions(opts...),
+		),
+		forceEndGame: connect.NewClient[proto.ForceEndGameRequest, proto.ForceEndGameResponse](
+			httpClient,
+			baseURL+AdminServiceForceEndGameProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ForceEndGame")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteGame: connect.NewClient[proto.DeleteGameRequest, proto.DeleteGameResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteGameProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteGame")),
+			connect.WithClientOptions(opts...),
+		),
+		resetUserStats: connect.NewClient[proto.ResetUserStatsRequest, proto.ResetUserStatsResponse](
+			httpClient,
+			baseURL+AdminServiceResetUserStatsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ResetUserStats")),
+			connect.WithClientOptions(opts...),
+		),
+		adjustUserStats: connect.NewClient[proto.AdjustUserStatsRequest, proto.AdjustUserStatsResponse](
+			httpClient,
+			baseURL+AdminServiceAdjustUserStatsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("AdjustUserStats")),
+			connect.WithClientOptions(opts...),
+		),
+		stats: connect.NewClient[proto.StatsRequest, proto.StatsResponse](
+			httpClient,
+			baseURL+AdminServiceStatsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("Stats")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	listGames       *connect.Client[proto.ListGamesRequest, proto.ListGamesResponse]
+	forceEndGame    *connect.Client[proto.ForceEndGameRequest, proto.ForceEndGameResponse]
+	deleteGame      *connect.Client[proto.DeleteGameRequest, proto.DeleteGameResponse]
+	resetUserStats  *connect.Client[proto.ResetUserStatsRequest, proto.ResetUserStatsResponse]
+	adjustUserStats *connect.Client[proto.AdjustUserStatsRequest, proto.AdjustUserStatsResponse]
+	stats           *connect.Client[proto.StatsRequest, proto.StatsResponse]
+}
+
+// ListGames calls tictactoe.AdminService.ListGames.
+func (c *adminServiceClient) ListGames(ctx context.Context, req *connect.Request[proto.ListGamesRequest]) (*connect.Response[proto.ListGamesResponse], error) {
+	return c.listGames.CallUnary(ctx, req)
+}
+
+// ForceEndGame calls tictactoe.AdminService.ForceEndGame.
+func (c *adminServiceClient) ForceEndGame(ctx context.Context, req *connect.Request[proto.ForceEndGameRequest]) (*connect.Response[proto.ForceEndGameResponse], error) {
+	return c.forceEndGame.CallUnary(ctx, req)
+}
+
+// DeleteGame calls tictactoe.AdminService.DeleteGame.
+func (c *adminServiceClient) DeleteGame(ctx context.Context, req *connect.Request[proto.DeleteGameRequest]) (*connect.Response[proto.DeleteGameResponse], error) {
+	return c.deleteGame.CallUnary(ctx, req)
+}
+
+// ResetUserStats calls tictactoe.AdminService.ResetUserStats.
+func (c *adminServiceClient) ResetUserStats(ctx context.Context, req *connect.Request[proto.ResetUserStatsRequest]) (*connect.Response[proto.ResetUserStatsResponse], error) {
+	return c.resetUserStats.CallUnary(ctx, req)
+}
+
+// AdjustUserStats calls tictactoe.AdminService.AdjustUserStats.
+func (c *adminServiceClient) AdjustUserStats(ctx context.Context, req *connect.Request[proto.AdjustUserStatsRequest]) (*connect.Response[proto.AdjustUserStatsResponse], error) {
+	return c.adjustUserStats.CallUnary(ctx, req)
+}
+
+// Stats calls tictactoe.AdminService.Stats.
+func (c *adminServiceClient) Stats(ctx context.Context, req *connect.Request[proto.StatsRequest]) (*connect.Response[proto.StatsResponse], error) {
+	return c.stats.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the tictactoe.AdminService service.
+type AdminServiceHandler interface {
+	ListGames(context.Context, *connect.Request[proto.ListGamesRequest]) (*connect.Response[proto.ListGamesResponse], error)
+	ForceEndGame(context.Context, *connect.Request[proto.ForceEndGameRequest]) (*connect.Response[proto.ForceEndGameResponse], error)
+	DeleteGame(context.Context, *connect.Request[proto.DeleteGameRequest]) (*connect.Response[proto.DeleteGameResponse], error)
+	ResetUserStats(context.Context, *connect.Request[proto.ResetUserStatsRequest]) (*connect.Response[proto.ResetUserStatsResponse], error)
+	AdjustUserStats(context.Context, *connect.Request[proto.AdjustUserStatsRequest]) (*connect.Response[proto.AdjustUserStatsResponse], error)
+	Stats(context.Context, *connect.Request[proto.StatsRequest]) (*connect.Response[proto.StatsResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := proto.File_proto_admin_proto.Services().ByName("AdminService").Methods()
+	adminServiceListGamesHandler := connect.NewUnaryHandler(
+		AdminServiceListGamesProcedure,
+		svc.ListGames,
+		connect.WithSchema(adminServiceMethods.ByName("ListGames")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceForceEndGameHandler := connect.NewUnaryHandler(
+		AdminServiceForceEndGameProcedure,
+		svc.ForceEndGame,
+		connect.WithSchema(adminServiceMethods.ByName("ForceEndGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteGameHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteGameProcedure,
+		svc.DeleteGame,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceResetUserStatsHandler := connect.NewUnaryHandler(
+		AdminServiceResetUserStatsProcedure,
+		svc.ResetUserStats,
+		connect.WithSchema(adminServiceMethods.ByName("ResetUserStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceAdjustUserStatsHandler := connect.NewUnaryHandler(
+		AdminServiceAdjustUserStatsProcedure,
+		svc.AdjustUserStats,
+		connect.WithSchema(adminServiceMethods.ByName("AdjustUserStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceStatsHandler := connect.NewUnaryHandler(
+		AdminServiceStatsProcedure,
+		svc.Stats,
+		connect.WithSchema(adminServiceMethods.ByName("Stats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/tictactoe.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceListGamesProcedure:
+			adminServiceListGamesHandler.ServeHTTP(w, r)
+		case AdminServiceForceEndGameProcedure:
+			adminServiceForceEndGameHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteGameProcedure:
+			adminServiceDeleteGameHandler.ServeHTTP(w, r)
+		case AdminServiceResetUserStatsProcedure:
+			adminServiceResetUserStatsHandler.ServeHTTP(w, r)
+		case AdminServiceAdjustUserStatsProcedure:
+			adminServiceAdjustUserStatsHandler.ServeHTTP(w, r)
+		case AdminServiceStatsProcedure:
+			adminServiceStatsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) ListGames(context.Context, *connect.Request[proto.ListGamesRequest]) (*connect.Response[proto.ListGamesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.AdminService.ListGames is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ForceEndGame(context.Context, *connect.Request[proto.ForceEndGameRequest]) (*connect.Response[proto.ForceEndGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.AdminService.ForceEndGame is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteGame(context.Context, *connect.Request[proto.DeleteGameRequest]) (*connect.Response[proto.DeleteGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.AdminService.DeleteGame is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ResetUserStats(context.Context, *connect.Request[proto.ResetUserStatsRequest]) (*connect.Response[proto.ResetUserStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.AdminService.ResetUserStats is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) AdjustUserStats(context.Context, *connect.Request[proto.AdjustUserStatsRequest]) (*connect.Response[proto.AdjustUserStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.AdminService.AdjustUserStats is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) Stats(context.Context, *connect.Request[proto.StatsRequest]) (*connect.Response[proto.StatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.AdminService.Stats is not implemented"))
+}
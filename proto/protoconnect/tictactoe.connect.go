@@ -0,0 +1,636 @@
+// proto/tictactoe.proto
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/tictactoe.proto
+
+package protoconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	proto "tictactoe/proto"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TicTacToeServiceName is the fully-qualified name of the TicTacToeService service.
+	TicTacToeServiceName = "tictactoe.TicTacToeService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TicTacToeServiceStartGameProcedure is the fully-qualified name of the TicTacToeService's
+	// StartGame RPC.
+	TicTacToeServiceStartGameProcedure = "/tictactoe.TicTacToeService/StartGame"
+	// TicTacToeServiceSearchPendingGamesProcedure is the fully-qualified name of the TicTacToeService's
+	// SearchPendingGames RPC.
+	TicTacToeServiceSearchPendingGamesProcedure = "/tictactoe.TicTacToeService/SearchPendingGames"
+	// TicTacToeServiceJoinGameProcedure is the fully-qualified name of the TicTacToeService's JoinGame
+	// RPC.
+	TicTacToeServiceJoinGameProcedure = "/tictactoe.TicTacToeService/JoinGame"
+	// TicTacToeServiceMakeMoveProcedure is the fully-qualified name of the TicTacToeService's MakeMove
+	// RPC.
+	TicTacToeServiceMakeMoveProcedure = "/tictactoe.TicTacToeService/MakeMove"
+	// TicTacToeServiceGetGameProcedure is the fully-qualified name of the TicTacToeService's GetGame
+	// RPC.
+	TicTacToeServiceGetGameProcedure = "/tictactoe.TicTacToeService/GetGame"
+	// TicTacToeServiceGetUserStatsProcedure is the fully-qualified name of the TicTacToeService's
+	// GetUserStats RPC.
+	TicTacToeServiceGetUserStatsProcedure = "/tictactoe.TicTacToeService/GetUserStats"
+	// TicTacToeServiceListUserGamesProcedure is the fully-qualified name of the TicTacToeService's
+	// ListUserGames RPC.
+	TicTacToeServiceListUserGamesProcedure = "/tictactoe.TicTacToeService/ListUserGames"
+	// TicTacToeServiceSpectateGameProcedure is the fully-qualified name of the TicTacToeService's
+	// SpectateGame RPC.
+	TicTacToeServiceSpectateGameProcedure = "/tictactoe.TicTacToeService/SpectateGame"
+	// TicTacToeServiceSetSpectatorsAllowedProcedure is the fully-qualified name of the
+	// TicTacToeService's SetSpectatorsAllowed RPC.
+	TicTacToeServiceSetSpectatorsAllowedProcedure = "/tictactoe.TicTacToeService/SetSpectatorsAllowed"
+	// TicTacToeServiceListLiveGamesProcedure is the fully-qualified name of the TicTacToeService's
+	// ListLiveGames RPC.
+	TicTacToeServiceListLiveGamesProcedure = "/tictactoe.TicTacToeService/ListLiveGames"
+	// TicTacToeServiceOfferRematchProcedure is the fully-qualified name of the TicTacToeService's
+	// OfferRematch RPC.
+	TicTacToeServiceOfferRematchProcedure = "/tictactoe.TicTacToeService/OfferRematch"
+	// TicTacToeServiceAcceptRematchProcedure is the fully-qualified name of the TicTacToeService's
+	// AcceptRematch RPC.
+	TicTacToeServiceAcceptRematchProcedure = "/tictactoe.TicTacToeService/AcceptRematch"
+	// TicTacToeServiceDeclineRematchProcedure is the fully-qualified name of the TicTacToeService's
+	// DeclineRematch RPC.
+	TicTacToeServiceDeclineRematchProcedure = "/tictactoe.TicTacToeService/DeclineRematch"
+	// TicTacToeServiceOfferDrawProcedure is the fully-qualified name of the TicTacToeService's
+	// OfferDraw RPC.
+	TicTacToeServiceOfferDrawProcedure = "/tictactoe.TicTacToeService/OfferDraw"
+	// TicTacToeServiceAcceptDrawProcedure is the fully-qualified name of the TicTacToeService's
+	// AcceptDraw RPC.
+	TicTacToeServiceAcceptDrawProcedure = "/tictactoe.TicTacToeService/AcceptDraw"
+	// TicTacToeServiceDeclineDrawProcedure is the fully-qualified name of the TicTacToeService's
+	// DeclineDraw RPC.
+	TicTacToeServiceDeclineDrawProcedure = "/tictactoe.TicTacToeService/DeclineDraw"
+	// TicTacToeServiceRequestTakebackProcedure is the fully-qualified name of the TicTacToeService's
+	// RequestTakeback RPC.
+	TicTacToeServiceRequestTakebackProcedure = "/tictactoe.TicTacToeService/RequestTakeback"
+	// TicTacToeServiceRespondTakebackProcedure is the fully-qualified name of the TicTacToeService's
+	// RespondTakeback RPC.
+	TicTacToeServiceRespondTakebackProcedure = "/tictactoe.TicTacToeService/RespondTakeback"
+)
+
+// TicTacToeServiceClient is a client for the tictactoe.TicTacToeService service.
+type TicTacToeServiceClient interface {
+	StartGame(context.Context, *connect.Request[proto.StartGameRequest]) (*connect.Response[proto.StartGameResponse], error)
+	SearchPendingGames(context.Context, *connect.Request[proto.SearchPendingGamesRequest]) (*connect.Response[proto.SearchPendingGamesResponse], error)
+	JoinGame(context.Context, *connect.Request[proto.JoinGameRequest]) (*connect.Response[proto.JoinGameResponse], error)
+	MakeMove(context.Context, *connect.Request[proto.MakeMoveRequest]) (*connect.Response[proto.MakeMoveResponse], error)
+	GetGame(context.Context, *connect.Request[proto.GetGameRequest]) (*connect.Response[proto.GetGameResponse], error)
+	GetUserStats(context.Context, *connect.Request[proto.GetUserStatsRequest]) (*connect.Response[proto.GetUserStatsResponse], error)
+	ListUserGames(context.Context, *connect.Request[proto.ListUserGamesRequest]) (*connect.Response[proto.ListUserGamesResponse], error)
+	// SpectateGame streams the game's state, starting with a snapshot and then
+	// after every change, until the game finishes. Players' streams stay open
+	// after the finish until any rematch offer is accepted, declined or
+	// expires.
+	SpectateGame(context.Context, *connect.Request[proto.SpectateGameRequest]) (*connect.ServerStreamForClient[proto.SpectateGameResponse], error)
+	SetSpectatorsAllowed(context.Context, *connect.Request[proto.SetSpectatorsAllowedRequest]) (*connect.Response[proto.SetSpectatorsAllowedResponse], error)
+	ListLiveGames(context.Context, *connect.Request[proto.ListLiveGamesRequest]) (*connect.Response[proto.ListLiveGamesResponse], error)
+	// OfferRematch offers the opponent a rematch of a finished game; the offer
+	// lapses if not answered in time.
+	OfferRematch(context.Context, *connect.Request[proto.OfferRematchRequest]) (*connect.Response[proto.OfferRematchResponse], error)
+	// AcceptRematch starts a new game between the same players with colors
+	// swapped, unless handicap stones were placed: those stay with the same
+	// player, so the seats do too.
+	AcceptRematch(context.Context, *connect.Request[proto.AcceptRematchRequest]) (*connect.Response[proto.AcceptRematchResponse], error)
+	DeclineRematch(context.Context, *connect.Request[proto.DeclineRematchRequest]) (*connect.Response[proto.DeclineRematchResponse], error)
+	// OfferDraw offers the opponent a draw; moving instead of answering
+	// declines it.
+	OfferDraw(context.Context, *connect.Request[proto.OfferDrawRequest]) (*connect.Response[proto.OfferDrawResponse], error)
+	AcceptDraw(context.Context, *connect.Request[proto.AcceptDrawRequest]) (*connect.Response[proto.AcceptDrawResponse], error)
+	DeclineDraw(context.Context, *connect.Request[proto.DeclineDrawRequest]) (*connect.Response[proto.DeclineDrawResponse], error)
+	// RequestTakeback asks the opponent to let the caller retract their last
+	// move. Only unranked games allow takebacks.
+	RequestTakeback(context.Context, *connect.Request[proto.RequestTakebackRequest]) (*connect.Response[proto.RequestTakebackResponse], error)
+	// RespondTakeback answers the opponent's request. Accepting removes the
+	// requester's last move, and the reply to it if any, and gives the turn
+	// back to the requester.
+	RespondTakeback(context.Context, *connect.Request[proto.RespondTakebackRequest]) (*connect.Response[proto.RespondTakebackResponse], error)
+}
+
+// NewTicTacToeServiceClient constructs a client for the tictactoe.TicTacToeService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTicTacToeServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TicTacToeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	ticTacToeServiceMethods := proto.File_proto_tictactoe_proto.Services().ByName("TicTacToeService").Methods()
+	return &ticTacToeServiceClient{
+		startGame: connect.NewClient[proto.StartGameRequest, proto.StartGameResponse](
+			httpClient,
+			baseURL+TicTacToeServiceStartGameProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("StartGame")),
+			connect.WithClientOptions(opts...),
+		),
+		searchPendingGames: connect.NewClient[proto.SearchPendingGamesRequest, proto.SearchPendingGamesResponse](
+			httpClient,
+			baseURL+TicTacToeServiceSearchPendingGamesProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("SearchPendingGames")),
+			connect.WithClientOptions(opts...),
+		),
+		joinGame: connect.NewClient[proto.JoinGameRequest, proto.JoinGameResponse](
+			httpClient,
+			baseURL+TicTacToeServiceJoinGameProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("JoinGame")),
+			connect.WithClientOptions(opts...),
+		),
+		makeMove: connect.NewClient[proto.MakeMoveRequest, proto.MakeMoveResponse](
+			httpClient,
+			baseURL+TicTacToeServiceMakeMoveProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("MakeMove")),
+			connect.WithClientOptions(opts...),
+		),
+		getGame: connect.NewClient[proto.GetGameRequest, proto.GetGameResponse](
+			httpClient,
+			baseURL+TicTacToeServiceGetGameProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("GetGame")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserStats: connect.NewClient[proto.GetUserStatsRequest, proto.GetUserStatsResponse](
+			httpClient,
+			baseURL+TicTacToeServiceGetUserStatsProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("GetUserStats")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserGames: connect.NewClient[proto.ListUserGamesRequest, proto.ListUserGamesResponse](
+			httpClient,
+			baseURL+TicTacToeServiceListUserGamesProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("ListUserGames")),
+			connect.WithClientOptions(opts...),
+		),
+		spectateGame: connect.NewClient[proto.SpectateGameRequest, proto.SpectateGameResponse](
+			httpClient,
+			baseURL+TicTacToeServiceSpectateGameProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("SpectateGame")),
+			connect.WithClientOptions(opts...),
+		),
+		setSpectatorsAllowed: connect.NewClient[proto.SetSpectatorsAllowedRequest, proto.SetSpectatorsAllowedResponse](
+			httpClient,
+			baseURL+TicTacToeServiceSetSpectatorsAllowedProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("SetSpectatorsAllowed")),
+			connect.WithClientOptions(opts...),
+		),
+		listLiveGames: connect.NewClient[proto.ListLiveGamesRequest, proto.ListLiveGamesResponse](
+			httpClient,
+			baseURL+TicTacToeServiceListLiveGamesProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("ListLiveGames")),
+			connect.WithClientOptions(opts...),
+		),
+		offerRematch: connect.NewClient[proto.OfferRematchRequest, proto.OfferRematchResponse](
+			httpClient,
+			baseURL+TicTacToeServiceOfferRematchProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("OfferRematch")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptRematch: connect.NewClient[proto.AcceptRematchRequest, proto.AcceptRematchResponse](
+			httpClient,
+			baseURL+TicTacToeServiceAcceptRematchProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("AcceptRematch")),
+			connect.WithClientOptions(opts...),
+		),
+		declineRematch: connect.NewClient[proto.DeclineRematchRequest, proto.DeclineRematchResponse](
+			httpClient,
+			baseURL+TicTacToeServiceDeclineRematchProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("DeclineRematch")),
+			connect.WithClientOptions(opts...),
+		),
+		offerDraw: connect.NewClient[proto.OfferDrawRequest, proto.OfferDrawResponse](
+			httpClient,
+			baseURL+TicTacToeServiceOfferDrawProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("OfferDraw")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptDraw: connect.NewClient[proto.AcceptDrawRequest, proto.AcceptDrawResponse](
+			httpClient,
+			baseURL+TicTacToeServiceAcceptDrawProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("AcceptDraw")),
+			connect.WithClientOptions(opts...),
+		),
+		declineDraw: connect.NewClient[proto.DeclineDrawRequest, proto.DeclineDrawResponse](
+			httpClient,
+			baseURL+TicTacToeServiceDeclineDrawProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("DeclineDraw")),
+			connect.WithClientOptions(opts...),
+		),
+		requestTakeback: connect.NewClient[proto.RequestTakebackRequest, proto.RequestTakebackResponse](
+			httpClient,
+			baseURL+TicTacToeServiceRequestTakebackProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("RequestTakeback")),
+			connect.WithClientOptions(opts...),
+		),
+		respondTakeback: connect.NewClient[proto.RespondTakebackRequest, proto.RespondTakebackResponse](
+			httpClient,
+			baseURL+TicTacToeServiceRespondTakebackProcedure,
+			connect.WithSchema(ticTacToeServiceMethods.ByName("RespondTakeback")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// ticTacToeServiceClient implements TicTacToeServiceClient.
+type ticTacToeServiceClient struct {
+	startGame            *connect.Client[proto.StartGameRequest, proto.StartGameResponse]
+	searchPendingGames   *connect.Client[proto.SearchPendingGamesRequest, proto.SearchPendingGamesResponse]
+	joinGame             *connect.Client[proto.JoinGameRequest, proto.JoinGameResponse]
+	makeMove             *connect.Client[proto.MakeMoveRequest, proto.MakeMoveResponse]
+	getGame              *connect.Client[proto.GetGameRequest, proto.GetGameResponse]
+	getUserStats         *connect.Client[proto.GetUserStatsRequest, proto.GetUserStatsResponse]
+	listUserGames        *connect.Client[proto.ListUserGamesRequest, proto.ListUserGamesResponse]
+	spectateGame         *connect.Client[proto.SpectateGameRequest, proto.SpectateGameResponse]
+	setSpectatorsAllowed *connect.Client[proto.SetSpectatorsAllowedRequest, proto.SetSpectatorsAllowedResponse]
+	listLiveGames        *connect.Client[proto.ListLiveGamesRequest, proto.ListLiveGamesResponse]
+	offerRematch         *connect.Client[proto.OfferRematchRequest, proto.OfferRematchResponse]
+	acceptRematch        *connect.Client[proto.AcceptRematchRequest, proto.AcceptRematchResponse]
+	declineRematch       *connect.Client[proto.DeclineRematchRequest, proto.DeclineRematchResponse]
+	offerDraw            *connect.Client[proto.OfferDrawRequest, proto.OfferDrawResponse]
+	acceptDraw           *connect.Client[proto.AcceptDrawRequest, proto.AcceptDrawResponse]
+	declineDraw          *connect.Client[proto.DeclineDrawRequest, proto.DeclineDrawResponse]
+	requestTakeback      *connect.Client[proto.RequestTakebackRequest, proto.RequestTakebackResponse]
+	respondTakeback      *connect.Client[proto.RespondTakebackRequest, proto.RespondTakebackResponse]
+}
+
+// StartGame calls tictactoe.TicTacToeService.StartGame.
+func (c *ticTacToeServiceClient) StartGame(ctx context.Context, req *connect.Request[proto.StartGameRequest]) (*connect.Response[proto.StartGameResponse], error) {
+	return c.startGame.CallUnary(ctx, req)
+}
+
+// SearchPendingGames calls tictactoe.TicTacToeService.SearchPendingGames.
+func (c *ticTacToeServiceClient) SearchPendingGames(ctx context.Context, req *connect.Request[proto.SearchPendingGamesRequest]) (*connect.Response[proto.SearchPendingGamesResponse], error) {
+	return c.searchPendingGames.CallUnary(ctx, req)
+}
+
+// JoinGame calls tictactoe.TicTacToeService.JoinGame.
+func (c *ticTacToeServiceClient) JoinGame(ctx context.Context, req *connect.Request[proto.JoinGameRequest]) (*connect.Response[proto.JoinGameResponse], error) {
+	return c.joinGame.CallUnary(ctx, req)
+}
+
+// MakeMove calls tictactoe.TicTacToeService.MakeMove.
+func (c *ticTacToeServiceClient) MakeMove(ctx context.Context, req *connect.Request[proto.MakeMoveRequest]) (*connect.Response[proto.MakeMoveResponse], error) {
+	return c.makeMove.CallUnary(ctx, req)
+}
+
+// GetGame calls tictactoe.TicTacToeService.GetGame.
+func (c *ticTacToeServiceClient) GetGame(ctx context.Context, req *connect.Request[proto.GetGameRequest]) (*connect.Response[proto.GetGameResponse], error) {
+	return c.getGame.CallUnary(ctx, req)
+}
+
+// GetUserStats calls tictactoe.TicTacToeService.GetUserStats.
+func (c *ticTacToeServiceClient) GetUserStats(ctx context.Context, req *connect.Request[proto.GetUserStatsRequest]) (*connect.Response[proto.GetUserStatsResponse], error) {
+	return c.getUserStats.CallUnary(ctx, req)
+}
+
+// ListUserGames calls tictactoe.TicTacToeService.ListUserGames.
+func (c *ticTacToeServiceClient) ListUserGames(ctx context.Context, req *connect.Request[proto.ListUserGamesRequest]) (*connect.Response[proto.ListUserGamesResponse], error) {
+	return c.listUserGames.CallUnary(ctx, req)
+}
+
+// SpectateGame calls tictactoe.TicTacToeService.SpectateGame.
+func (c *ticTacToeServiceClient) SpectateGame(ctx context.Context, req *connect.Request[proto.SpectateGameRequest]) (*connect.ServerStreamForClient[proto.SpectateGameResponse], error) {
+	return c.spectateGame.CallServerStream(ctx, req)
+}
+
+// SetSpectatorsAllowed calls tictactoe.TicTacToeService.SetSpectatorsAllowed.
+func (c *ticTacToeServiceClient) SetSpectatorsAllowed(ctx context.Context, req *connect.Request[proto.SetSpectatorsAllowedRequest]) (*connect.Response[proto.SetSpectatorsAllowedResponse], error) {
+	return c.setSpectatorsAllowed.CallUnary(ctx, req)
+}
+
+// ListLiveGames calls tictactoe.TicTacToeService.ListLiveGames.
+func (c *ticTacToeServiceClient) ListLiveGames(ctx context.Context, req *connect.Request[proto.ListLiveGamesRequest]) (*connect.Response[proto.ListLiveGamesResponse], error) {
+	return c.listLiveGames.CallUnary(ctx, req)
+}
+
+// OfferRematch calls tictactoe.TicTacToeService.OfferRematch.
+func (c *ticTacToeServiceClient) OfferRematch(ctx context.Context, req *connect.Request[proto.OfferRematchRequest]) (*connect.Response[proto.OfferRematchResponse], error) {
+	return c.offerRematch.CallUnary(ctx, req)
+}
+
+// AcceptRematch calls tictactoe.TicTacToeService.AcceptRematch.
+func (c *ticTacToeServiceClient) AcceptRematch(ctx context.Context, req *connect.Request[proto.AcceptRematchRequest]) (*connect.Response[proto.AcceptRematchResponse], error) {
+	return c.acceptRematch.CallUnary(ctx, req)
+}
+
+// DeclineRematch calls tictactoe.TicTacToeService.DeclineRematch.
+func (c *ticTacToeServiceClient) DeclineRematch(ctx context.Context, req *connect.Request[proto.DeclineRematchRequest]) (*connect.Response[proto.DeclineRematchResponse], error) {
+	return c.declineRematch.CallUnary(ctx, req)
+}
+
+// OfferDraw calls tictactoe.TicTacToeService.OfferDraw.
+func (c *ticTacToeServiceClient) OfferDraw(ctx context.Context, req *connect.Request[proto.OfferDrawRequest]) (*connect.Response[proto.OfferDrawResponse], error) {
+	return c.offerDraw.CallUnary(ctx, req)
+}
+
+// AcceptDraw calls tictactoe.TicTacToeService.AcceptDraw.
+func (c *ticTacToeServiceClient) AcceptDraw(ctx context.Context, req *connect.Request[proto.AcceptDrawRequest]) (*connect.Response[proto.AcceptDrawResponse], error) {
+	return c.acceptDraw.CallUnary(ctx, req)
+}
+
+// DeclineDraw calls tictactoe.TicTacToeService.DeclineDraw.
+func (c *ticTacToeServiceClient) DeclineDraw(ctx context.Context, req *connect.Request[proto.DeclineDrawRequest]) (*connect.Response[proto.DeclineDrawResponse], error) {
+	return c.declineDraw.CallUnary(ctx, req)
+}
+
+// RequestTakeback calls tictactoe.TicTacToeService.RequestTakeback.
+func (c *ticTacToeServiceClient) RequestTakeback(ctx context.Context, req *connect.Request[proto.RequestTakebackRequest]) (*connect.Response[proto.RequestTakebackResponse], error) {
+	return c.requestTakeback.CallUnary(ctx, req)
+}
+
+// RespondTakeback calls tictactoe.TicTacToeService.RespondTakeback.
+func (c *ticTacToeServiceClient) RespondTakeback(ctx context.Context, req *connect.Request[proto.RespondTakebackRequest]) (*connect.Response[proto.RespondTakebackResponse], error) {
+	return c.respondTakeback.CallUnary(ctx, req)
+}
+
+// TicTacToeServiceHandler is an implementation of the tictactoe.TicTacToeService service.
+type TicTacToeServiceHandler interface {
+	StartGame(context.Context, *connect.Request[proto.StartGameRequest]) (*connect.Response[proto.StartGameResponse], error)
+	SearchPendingGames(context.Context, *connect.Request[proto.SearchPendingGamesRequest]) (*connect.Response[proto.SearchPendingGamesResponse], error)
+	JoinGame(context.Context, *connect.Request[proto.JoinGameRequest]) (*connect.Response[proto.JoinGameResponse], error)
+	MakeMove(context.Context, *connect.Request[proto.MakeMoveRequest]) (*connect.Response[proto.MakeMoveResponse], error)
+	GetGame(context.Context, *connect.Request[proto.GetGameRequest]) (*connect.Response[proto.GetGameResponse], error)
+	GetUserStats(context.Context, *connect.Request[proto.GetUserStatsRequest]) (*connect.Response[proto.GetUserStatsResponse], error)
+	ListUserGames(context.Context, *connect.Request[proto.ListUserGamesRequest]) (*connect.Response[proto.ListUserGamesResponse], error)
+	// SpectateGame streams the game's state, starting with a snapshot and then
+	// after every change, until the game finishes. Players' streams stay open
+	// after the finish until any rematch offer is accepted, declined or
+	// expires.
+	SpectateGame(context.Context, *connect.Request[proto.SpectateGameRequest], *connect.ServerStream[proto.SpectateGameResponse]) error
+	SetSpectatorsAllowed(context.Context, *connect.Request[proto.SetSpectatorsAllowedRequest]) (*connect.Response[proto.SetSpectatorsAllowedResponse], error)
+	ListLiveGames(context.Context, *connect.Request[proto.ListLiveGamesRequest]) (*connect.Response[proto.ListLiveGamesResponse], error)
+	// OfferRematch offers the opponent a rematch of a finished game; the offer
+	// lapses if not answered in time.
+	OfferRematch(context.Context, *connect.Request[proto.OfferRematchRequest]) (*connect.Response[proto.OfferRematchResponse], error)
+	// AcceptRematch starts a new game between the same players with colors
+	// swapped, unless handicap stones were placed: those stay with the same
+	// player, so the seats do too.
+	AcceptRematch(context.Context, *connect.Request[proto.AcceptRematchRequest]) (*connect.Response[proto.AcceptRematchResponse], error)
+	DeclineRematch(context.Context, *connect.Request[proto.DeclineRematchRequest]) (*connect.Response[proto.DeclineRematchResponse], error)
+	// OfferDraw offers the opponent a draw; moving instead of answering
+	// declines it.
+	OfferDraw(context.Context, *connect.Request[proto.OfferDrawRequest]) (*connect.Response[proto.OfferDrawResponse], error)
+	AcceptDraw(context.Context, *connect.Request[proto.AcceptDrawRequest]) (*connect.Response[proto.AcceptDrawResponse], error)
+	DeclineDraw(context.Context, *connect.Request[proto.DeclineDrawRequest]) (*connect.Response[proto.DeclineDrawResponse], error)
+	// RequestTakeback asks the opponent to let the caller retract their last
+	// move. Only unranked games allow takebacks.
+	RequestTakeback(context.Context, *connect.Request[proto.RequestTakebackRequest]) (*connect.Response[proto.RequestTakebackResponse], error)
+	// RespondTakeback answers the opponent's request. Accepting removes the
+	// requester's last move, and the reply to it if any, and gives the turn
+	// back to the requester.
+	RespondTakeback(context.Context, *connect.Request[proto.RespondTakebackRequest]) (*connect.Response[proto.RespondTakebackResponse], error)
+}
+
+// NewTicTacToeServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTicTacToeServiceHandler(svc TicTacToeServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	ticTacToeServiceMethods := proto.File_proto_tictactoe_proto.Services().ByName("TicTacToeService").Methods()
+	ticTacToeServiceStartGameHandler := connect.NewUnaryHandler(
+		TicTacToeServiceStartGameProcedure,
+		svc.StartGame,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("StartGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceSearchPendingGamesHandler := connect.NewUnaryHandler(
+		TicTacToeServiceSearchPendingGamesProcedure,
+		svc.SearchPendingGames,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("SearchPendingGames")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceJoinGameHandler := connect.NewUnaryHandler(
+		TicTacToeServiceJoinGameProcedure,
+		svc.JoinGame,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("JoinGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceMakeMoveHandler := connect.NewUnaryHandler(
+		TicTacToeServiceMakeMoveProcedure,
+		svc.MakeMove,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("MakeMove")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceGetGameHandler := connect.NewUnaryHandler(
+		TicTacToeServiceGetGameProcedure,
+		svc.GetGame,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("GetGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceGetUserStatsHandler := connect.NewUnaryHandler(
+		TicTacToeServiceGetUserStatsProcedure,
+		svc.GetUserStats,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("GetUserStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceListUserGamesHandler := connect.NewUnaryHandler(
+		TicTacToeServiceListUserGamesProcedure,
+		svc.ListUserGames,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("ListUserGames")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceSpectateGameHandler := connect.NewServerStreamHandler(
+		TicTacToeServiceSpectateGameProcedure,
+		svc.SpectateGame,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("SpectateGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceSetSpectatorsAllowedHandler := connect.NewUnaryHandler(
+		TicTacToeServiceSetSpectatorsAllowedProcedure,
+		svc.SetSpectatorsAllowed,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("SetSpectatorsAllowed")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceListLiveGamesHandler := connect.NewUnaryHandler(
+		TicTacToeServiceListLiveGamesProcedure,
+		svc.ListLiveGames,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("ListLiveGames")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceOfferRematchHandler := connect.NewUnaryHandler(
+		TicTacToeServiceOfferRematchProcedure,
+		svc.OfferRematch,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("OfferRematch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceAcceptRematchHandler := connect.NewUnaryHandler(
+		TicTacToeServiceAcceptRematchProcedure,
+		svc.AcceptRematch,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("AcceptRematch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceDeclineRematchHandler := connect.NewUnaryHandler(
+		TicTacToeServiceDeclineRematchProcedure,
+		svc.DeclineRematch,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("DeclineRematch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceOfferDrawHandler := connect.NewUnaryHandler(
+		TicTacToeServiceOfferDrawProcedure,
+		svc.OfferDraw,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("OfferDraw")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceAcceptDrawHandler := connect.NewUnaryHandler(
+		TicTacToeServiceAcceptDrawProcedure,
+		svc.AcceptDraw,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("AcceptDraw")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceDeclineDrawHandler := connect.NewUnaryHandler(
+		TicTacToeServiceDeclineDrawProcedure,
+		svc.DeclineDraw,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("DeclineDraw")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceRequestTakebackHandler := connect.NewUnaryHandler(
+		TicTacToeServiceRequestTakebackProcedure,
+		svc.RequestTakeback,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("RequestTakeback")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticTacToeServiceRespondTakebackHandler := connect.NewUnaryHandler(
+		TicTacToeServiceRespondTakebackProcedure,
+		svc.RespondTakeback,
+		connect.WithSchema(ticTacToeServiceMethods.ByName("RespondTakeback")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/tictactoe.TicTacToeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TicTacToeServiceStartGameProcedure:
+			ticTacToeServiceStartGameHandler.ServeHTTP(w, r)
+		case TicTacToeServiceSearchPendingGamesProcedure:
+			ticTacToeServiceSearchPendingGamesHandler.ServeHTTP(w, r)
+		case TicTacToeServiceJoinGameProcedure:
+			ticTacToeServiceJoinGameHandler.ServeHTTP(w, r)
+		case TicTacToeServiceMakeMoveProcedure:
+			ticTacToeServiceMakeMoveHandler.ServeHTTP(w, r)
+		case TicTacToeServiceGetGameProcedure:
+			ticTacToeServiceGetGameHandler.ServeHTTP(w, r)
+		case TicTacToeServiceGetUserStatsProcedure:
+			ticTacToeServiceGetUserStatsHandler.ServeHTTP(w, r)
+		case TicTacToeServiceListUserGamesProcedure:
+			ticTacToeServiceListUserGamesHandler.ServeHTTP(w, r)
+		case TicTacToeServiceSpectateGameProcedure:
+			ticTacToeServiceSpectateGameHandler.ServeHTTP(w, r)
+		case TicTacToeServiceSetSpectatorsAllowedProcedure:
+			ticTacToeServiceSetSpectatorsAllowedHandler.ServeHTTP(w, r)
+		case TicTacToeServiceListLiveGamesProcedure:
+			ticTacToeServiceListLiveGamesHandler.ServeHTTP(w, r)
+		case TicTacToeServiceOfferRematchProcedure:
+			ticTacToeServiceOfferRematchHandler.ServeHTTP(w, r)
+		case TicTacToeServiceAcceptRematchProcedure:
+			ticTacToeServiceAcceptRematchHandler.ServeHTTP(w, r)
+		case TicTacToeServiceDeclineRematchProcedure:
+			ticTacToeServiceDeclineRematchHandler.ServeHTTP(w, r)
+		case TicTacToeServiceOfferDrawProcedure:
+			ticTacToeServiceOfferDrawHandler.ServeHTTP(w, r)
+		case TicTacToeServiceAcceptDrawProcedure:
+			ticTacToeServiceAcceptDrawHandler.ServeHTTP(w, r)
+		case TicTacToeServiceDeclineDrawProcedure:
+			ticTacToeServiceDeclineDrawHandler.ServeHTTP(w, r)
+		case TicTacToeServiceRequestTakebackProcedure:
+			ticTacToeServiceRequestTakebackHandler.ServeHTTP(w, r)
+		case TicTacToeServiceRespondTakebackProcedure:
+			ticTacToeServiceRespondTakebackHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTicTacToeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTicTacToeServiceHandler struct{}
+
+func (UnimplementedTicTacToeServiceHandler) StartGame(context.Context, *connect.Request[proto.StartGameRequest]) (*connect.Response[proto.StartGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.StartGame is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) SearchPendingGames(context.Context, *connect.Request[proto.SearchPendingGamesRequest]) (*connect.Response[proto.SearchPendingGamesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.SearchPendingGames is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) JoinGame(context.Context, *connect.Request[proto.JoinGameRequest]) (*connect.Response[proto.JoinGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.JoinGame is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) MakeMove(context.Context, *connect.Request[proto.MakeMoveRequest]) (*connect.Response[proto.MakeMoveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.MakeMove is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) GetGame(context.Context, *connect.Request[proto.GetGameRequest]) (*connect.Response[proto.GetGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.GetGame is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) GetUserStats(context.Context, *connect.Request[proto.GetUserStatsRequest]) (*connect.Response[proto.GetUserStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.GetUserStats is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) ListUserGames(context.Context, *connect.Request[proto.ListUserGamesRequest]) (*connect.Response[proto.ListUserGamesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.ListUserGames is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) SpectateGame(context.Context, *connect.Request[proto.SpectateGameRequest], *connect.ServerStream[proto.SpectateGameResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.SpectateGame is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) SetSpectatorsAllowed(context.Context, *connect.Request[proto.SetSpectatorsAllowedRequest]) (*connect.Response[proto.SetSpectatorsAllowedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.SetSpectatorsAllowed is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) ListLiveGames(context.Context, *connect.Request[proto.ListLiveGamesRequest]) (*connect.Response[proto.ListLiveGamesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.ListLiveGames is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) OfferRematch(context.Context, *connect.Request[proto.OfferRematchRequest]) (*connect.Response[proto.OfferRematchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.OfferRematch is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) AcceptRematch(context.Context, *connect.Request[proto.AcceptRematchRequest]) (*connect.Response[proto.AcceptRematchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.AcceptRematch is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) DeclineRematch(context.Context, *connect.Request[proto.DeclineRematchRequest]) (*connect.Response[proto.DeclineRematchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.DeclineRematch is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) OfferDraw(context.Context, *connect.Request[proto.OfferDrawRequest]) (*connect.Response[proto.OfferDrawResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.OfferDraw is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) AcceptDraw(context.Context, *connect.Request[proto.AcceptDrawRequest]) (*connect.Response[proto.AcceptDrawResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.AcceptDraw is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) DeclineDraw(context.Context, *connect.Request[proto.DeclineDrawRequest]) (*connect.Response[proto.DeclineDrawResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.DeclineDraw is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) RequestTakeback(context.Context, *connect.Request[proto.RequestTakebackRequest]) (*connect.Response[proto.RequestTakebackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.RequestTakeback is not implemented"))
+}
+
+func (UnimplementedTicTacToeServiceHandler) RespondTakeback(context.Context, *connect.Request[proto.RespondTakebackRequest]) (*connect.Response[proto.RespondTakebackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tictactoe.TicTacToeService.RespondTakeback is not implemented"))
+}
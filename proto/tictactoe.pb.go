@@ -9,6 +9,7 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -23,6 +24,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PendingGameOrder int32
+
+const (
+	PendingGameOrder_ORDER_OLDEST_FIRST PendingGameOrder = 0 // longest-waiting games first
+	PendingGameOrder_ORDER_NEWEST_FIRST PendingGameOrder = 1
+	PendingGameOrder_ORDER_CREATOR_WINS PendingGameOrder = 2 // creators with the most wins first
+)
+
+// Enum value maps for PendingGameOrder.
+var (
+	PendingGameOrder_name = map[int32]string{
+		0: "ORDER_OLDEST_FIRST",
+		1: "ORDER_NEWEST_FIRST",
+		2: "ORDER_CREATOR_WINS",
+	}
+	PendingGameOrder_value = map[string]int32{
+		"ORDER_OLDEST_FIRST": 0,
+		"ORDER_NEWEST_FIRST": 1,
+		"ORDER_CREATOR_WINS": 2,
+	}
+)
+
+func (x PendingGameOrder) Enum() *PendingGameOrder {
+	p := new(PendingGameOrder)
+	*p = x
+	return p
+}
+
+func (x PendingGameOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PendingGameOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_tictactoe_proto_enumTypes[0].Descriptor()
+}
+
+func (PendingGameOrder) Type() protoreflect.EnumType {
+	return &file_proto_tictactoe_proto_enumTypes[0]
+}
+
+func (x PendingGameOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PendingGameOrder.Descriptor instead.
+func (PendingGameOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{0}
+}
+
+type RematchStatus int32
+
+const (
+	RematchStatus_REMATCH_NONE     RematchStatus = 0
+	RematchStatus_REMATCH_OFFERED  RematchStatus = 1
+	RematchStatus_REMATCH_ACCEPTED RematchStatus = 2
+	RematchStatus_REMATCH_DECLINED RematchStatus = 3
+	RematchStatus_REMATCH_EXPIRED  RematchStatus = 4
+)
+
+// Enum value maps for RematchStatus.
+var (
+	RematchStatus_name = map[int32]string{
+		0: "REMATCH_NONE",
+		1: "REMATCH_OFFERED",
+		2: "REMATCH_ACCEPTED",
+		3: "REMATCH_DECLINED",
+		4: "REMATCH_EXPIRED",
+	}
+	RematchStatus_value = map[string]int32{
+		"REMATCH_NONE":     0,
+		"REMATCH_OFFERED":  1,
+		"REMATCH_ACCEPTED": 2,
+		"REMATCH_DECLINED": 3,
+		"REMATCH_EXPIRED":  4,
+	}
+)
+
+func (x RematchStatus) Enum() *RematchStatus {
+	p := new(RematchStatus)
+	*p = x
+	return p
+}
+
+func (x RematchStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RematchStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_tictactoe_proto_enumTypes[1].Descriptor()
+}
+
+func (RematchStatus) Type() protoreflect.EnumType {
+	return &file_proto_tictactoe_proto_enumTypes[1]
+}
+
+func (x RematchStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RematchStatus.Descriptor instead.
+func (RematchStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{1}
+}
+
 type GameStatus int32
 
 const (
@@ -62,11 +167,11 @@ func (x GameStatus) String() string {
 }
 
 func (GameStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_tictactoe_proto_enumTypes[0].Descriptor()
+	return file_proto_tictactoe_proto_enumTypes[2].Descriptor()
 }
 
 func (GameStatus) Type() protoreflect.EnumType {
-	return &file_proto_tictactoe_proto_enumTypes[0]
+	return &file_proto_tictactoe_proto_enumTypes[2]
 }
 
 func (x GameStatus) Number() protoreflect.EnumNumber {
@@ -75,7 +180,7 @@ func (x GameStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GameStatus.Descriptor instead.
 func (GameStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_tictactoe_proto_rawDescGZIP(), []int{0}
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{2}
 }
 
 type StartGameRequest struct {
@@ -83,6 +188,30 @@ type StartGameRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	BoardSize     int32                  `protobuf:"varint,2,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"`             // optional, defaults to 3
 	WinningLength int32                  `protobuf:"varint,3,opt,name=winning_length,json=winningLength,proto3" json:"winning_length,omitempty"` // optional, defaults to 3
+	// optional; private games are hidden from SearchPendingGames and
+	// matchmaking and can only be joined by invitation or join code
+	Private       bool   `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	InvitedUserId string `protobuf:"bytes,5,opt,name=invited_user_id,json=invitedUserId,proto3" json:"invited_user_id,omitempty"` // optional, implies private
+	// optional rules variant: "standard" (default), "gomoku", "renju",
+	// "misere", "gravity" or "ultimate" (always 9x9)
+	Variant string `protobuf:"bytes,6,opt,name=variant,proto3" json:"variant,omitempty"`
+	// optional; board_width and board_height make a rectangular board and
+	// each default to board_size
+	BoardWidth  int32 `protobuf:"varint,7,opt,name=board_width,json=boardWidth,proto3" json:"board_width,omitempty"`
+	BoardHeight int32 `protobuf:"varint,8,opt,name=board_height,json=boardHeight,proto3" json:"board_height,omitempty"`
+	// optional number of players, 2 (default) to 4; the game starts once
+	// every seat is filled. Misère games always seat two.
+	Seats int32 `protobuf:"varint,9,opt,name=seats,proto3" json:"seats,omitempty"`
+	// optional cells nobody may play on; random_obstacles more empty cells
+	// are blocked at random. Games with obstacles or handicap stones are never
+	// matched with other games. Gravity and ultimate games reject both.
+	Obstacles       []*Position `protobuf:"bytes,10,rep,name=obstacles,proto3" json:"obstacles,omitempty"`
+	RandomObstacles int32       `protobuf:"varint,11,opt,name=random_obstacles,json=randomObstacles,proto3" json:"random_obstacles,omitempty"`
+	// optional stones placed for the second player before the first move
+	HandicapStones []*Position `protobuf:"bytes,12,rep,name=handicap_stones,json=handicapStones,proto3" json:"handicap_stones,omitempty"`
+	// optional; ranked games allow no takebacks and are only matched with
+	// ranked games. Defaults to ranked for public games, casual for private.
+	Ranked        *bool `protobuf:"varint,13,opt,name=ranked,proto3,oneof" json:"ranked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -138,11 +267,82 @@ func (x *StartGameRequest) GetWinningLength() int32 {
 	return 0
 }
 
+func (x *StartGameRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *StartGameRequest) GetInvitedUserId() string {
+	if x != nil {
+		return x.InvitedUserId
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetBoardWidth() int32 {
+	if x != nil {
+		return x.BoardWidth
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetBoardHeight() int32 {
+	if x != nil {
+		return x.BoardHeight
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetObstacles() []*Position {
+	if x != nil {
+		return x.Obstacles
+	}
+	return nil
+}
+
+func (x *StartGameRequest) GetRandomObstacles() int32 {
+	if x != nil {
+		return x.RandomObstacles
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetHandicapStones() []*Position {
+	if x != nil {
+		return x.HandicapStones
+	}
+	return nil
+}
+
+func (x *StartGameRequest) GetRanked() bool {
+	if x != nil && x.Ranked != nil {
+		return *x.Ranked
+	}
+	return false
+}
+
 type StartGameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	Status        GameStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=tictactoe.GameStatus" json:"status,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	JoinCode      string                 `protobuf:"bytes,4,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"` // set for private games
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -198,10 +398,28 @@ func (x *StartGameResponse) GetMessage() string {
 	return ""
 }
 
+func (x *StartGameResponse) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
 type SearchPendingGamesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BoardSize     int32                  `protobuf:"varint,1,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"`             // optional filter
-	WinningLength int32                  `protobuf:"varint,2,opt,name=winning_length,json=winningLength,proto3" json:"winning_length,omitempty"` // optional filter
+	BoardSize     int32                  `protobuf:"varint,1,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"`                           // optional filter, matches square boards of this size
+	WinningLength int32                  `protobuf:"varint,2,opt,name=winning_length,json=winningLength,proto3" json:"winning_length,omitempty"`               // optional filter
+	CreatorId     string                 `protobuf:"bytes,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`                            // optional filter
+	MaxAgeSeconds int64                  `protobuf:"varint,4,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`             // optional, only games created at most this long ago
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                     // optional, the caller; their own games are excluded
+	OrderBy       PendingGameOrder       `protobuf:"varint,6,opt,name=order_by,json=orderBy,proto3,enum=tictactoe.PendingGameOrder" json:"order_by,omitempty"` // optional, defaults to ORDER_OLDEST_FIRST
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                            // optional, next_page_token from a previous call
+	PageSize      int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                              // optional, defaults to 20, at most 100
+	Variant       string                 `protobuf:"bytes,9,opt,name=variant,proto3" json:"variant,omitempty"`                                                 // optional filter
+	BoardWidth    int32                  `protobuf:"varint,10,opt,name=board_width,json=boardWidth,proto3" json:"board_width,omitempty"`                       // optional filter
+	BoardHeight   int32                  `protobuf:"varint,11,opt,name=board_height,json=boardHeight,proto3" json:"board_height,omitempty"`                    // optional filter
+	Seats         int32                  `protobuf:"varint,12,opt,name=seats,proto3" json:"seats,omitempty"`                                                   // optional filter
+	Ranked        *bool                  `protobuf:"varint,13,opt,name=ranked,proto3,oneof" json:"ranked,omitempty"`                                           // optional filter
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -250,9 +468,88 @@ func (x *SearchPendingGamesRequest) GetWinningLength() int32 {
 	return 0
 }
 
+func (x *SearchPendingGamesRequest) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *SearchPendingGamesRequest) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *SearchPendingGamesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchPendingGamesRequest) GetOrderBy() PendingGameOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return PendingGameOrder_ORDER_OLDEST_FIRST
+}
+
+func (x *SearchPendingGamesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchPendingGamesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPendingGamesRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *SearchPendingGamesRequest) GetBoardWidth() int32 {
+	if x != nil {
+		return x.BoardWidth
+	}
+	return 0
+}
+
+func (x *SearchPendingGamesRequest) GetBoardHeight() int32 {
+	if x != nil {
+		return x.BoardHeight
+	}
+	return 0
+}
+
+func (x *SearchPendingGamesRequest) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *SearchPendingGamesRequest) GetRanked() bool {
+	if x != nil && x.Ranked != nil {
+		return *x.Ranked
+	}
+	return false
+}
+
 type SearchPendingGamesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Games         []*PendingGame         `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`              // number of matching games across all pages
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -294,15 +591,37 @@ func (x *SearchPendingGamesResponse) GetGames() []*PendingGame {
 	return nil
 }
 
+func (x *SearchPendingGamesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchPendingGamesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
 type PendingGame struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	CreatorId     string                 `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	BoardSize     int32                  `protobuf:"varint,3,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"`
-	WinningLength int32                  `protobuf:"varint,4,opt,name=winning_length,json=winningLength,proto3" json:"winning_length,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GameId         string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	CreatorId      string                 `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	BoardSize      int32                  `protobuf:"varint,3,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"` // set for square boards only
+	WinningLength  int32                  `protobuf:"varint,4,opt,name=winning_length,json=winningLength,proto3" json:"winning_length,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Variant        string                 `protobuf:"bytes,6,opt,name=variant,proto3" json:"variant,omitempty"`
+	BoardWidth     int32                  `protobuf:"varint,7,opt,name=board_width,json=boardWidth,proto3" json:"board_width,omitempty"`
+	BoardHeight    int32                  `protobuf:"varint,8,opt,name=board_height,json=boardHeight,proto3" json:"board_height,omitempty"`
+	Seats          int32                  `protobuf:"varint,9,opt,name=seats,proto3" json:"seats,omitempty"`
+	OpenSeats      int32                  `protobuf:"varint,10,opt,name=open_seats,json=openSeats,proto3" json:"open_seats,omitempty"`                // seats still to be filled before the game starts
+	Obstacles      int32                  `protobuf:"varint,11,opt,name=obstacles,proto3" json:"obstacles,omitempty"`                                 // number of blocked cells
+	HandicapStones int32                  `protobuf:"varint,12,opt,name=handicap_stones,json=handicapStones,proto3" json:"handicap_stones,omitempty"` // number of handicap stones
+	Ranked         bool                   `protobuf:"varint,13,opt,name=ranked,proto3" json:"ranked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PendingGame) Reset() {
@@ -370,10 +689,67 @@ func (x *PendingGame) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *PendingGame) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *PendingGame) GetBoardWidth() int32 {
+	if x != nil {
+		return x.BoardWidth
+	}
+	return 0
+}
+
+func (x *PendingGame) GetBoardHeight() int32 {
+	if x != nil {
+		return x.BoardHeight
+	}
+	return 0
+}
+
+func (x *PendingGame) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *PendingGame) GetOpenSeats() int32 {
+	if x != nil {
+		return x.OpenSeats
+	}
+	return 0
+}
+
+func (x *PendingGame) GetObstacles() int32 {
+	if x != nil {
+		return x.Obstacles
+	}
+	return 0
+}
+
+func (x *PendingGame) GetHandicapStones() int32 {
+	if x != nil {
+		return x.HandicapStones
+	}
+	return 0
+}
+
+func (x *PendingGame) GetRanked() bool {
+	if x != nil {
+		return x.Ranked
+	}
+	return false
+}
+
 type JoinGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`       // optional when join_code is set
+	JoinCode      string                 `protobuf:"bytes,3,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"` // required for private games unless invited
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -422,6 +798,13 @@ func (x *JoinGameRequest) GetGameId() string {
 	return ""
 }
 
+func (x *JoinGameRequest) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
 type JoinGameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        GameStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=tictactoe.GameStatus" json:"status,omitempty"`
@@ -486,7 +869,7 @@ type MakeMoveRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Row           int32                  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Row           int32                  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"` // ignored in gravity games, where the piece drops down col
 	Col           int32                  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -794,37 +1177,30 @@ func (x *GetUserStatsResponse) GetStats() *UserStats {
 	return nil
 }
 
-type Game struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Player1Id       string                 `protobuf:"bytes,2,opt,name=player1_id,json=player1Id,proto3" json:"player1_id,omitempty"`
-	Player2Id       string                 `protobuf:"bytes,3,opt,name=player2_id,json=player2Id,proto3" json:"player2_id,omitempty"`
-	Board           []string               `protobuf:"bytes,4,rep,name=board,proto3" json:"board,omitempty"` // flattened board representation
-	BoardSize       int32                  `protobuf:"varint,5,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"`
-	WinningLength   int32                  `protobuf:"varint,6,opt,name=winning_length,json=winningLength,proto3" json:"winning_length,omitempty"`
-	Status          GameStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=tictactoe.GameStatus" json:"status,omitempty"`
-	CurrentPlayerId string                 `protobuf:"bytes,8,opt,name=current_player_id,json=currentPlayerId,proto3" json:"current_player_id,omitempty"`
-	WinnerId        string                 `protobuf:"bytes,9,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
-	CreatedAt       int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ListUserGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StatusFilter  []GameStatus           `protobuf:"varint,2,rep,packed,name=status_filter,json=statusFilter,proto3,enum=tictactoe.GameStatus" json:"status_filter,omitempty"` // optional, defaults to every status
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                            // optional, next_page_token from a previous call
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                              // optional, defaults to 20, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Game) Reset() {
-	*x = Game{}
+func (x *ListUserGamesRequest) Reset() {
+	*x = ListUserGamesRequest{}
 	mi := &file_proto_tictactoe_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Game) String() string {
+func (x *ListUserGamesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Game) ProtoMessage() {}
+func (*ListUserGamesRequest) ProtoMessage() {}
 
-func (x *Game) ProtoReflect() protoreflect.Message {
+func (x *ListUserGamesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tictactoe_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -836,102 +1212,1824 @@ func (x *Game) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Game.ProtoReflect.Descriptor instead.
-func (*Game) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserGamesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tictactoe_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Game) GetId() string {
+func (x *ListUserGamesRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Game) GetPlayer1Id() string {
+func (x *ListUserGamesRequest) GetStatusFilter() []GameStatus {
 	if x != nil {
-		return x.Player1Id
+		return x.StatusFilter
 	}
-	return ""
+	return nil
 }
 
-func (x *Game) GetPlayer2Id() string {
+func (x *ListUserGamesRequest) GetPageToken() string {
 	if x != nil {
-		return x.Player2Id
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *Game) GetBoard() []string {
+func (x *ListUserGamesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Board
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *Game) GetBoardSize() int32 {
-	if x != nil {
-		return x.BoardSize
-	}
-	return 0
+type ListUserGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*UserGame            `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`                                        // active games first, then finished games newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Game) GetWinningLength() int32 {
+func (x *ListUserGamesResponse) Reset() {
+	*x = ListUserGamesResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserGamesResponse) ProtoMessage() {}
+
+func (x *ListUserGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListUserGamesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListUserGamesResponse) GetGames() []*UserGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *ListUserGamesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UserGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	YourTurn      bool                   `protobuf:"varint,2,opt,name=your_turn,json=yourTurn,proto3" json:"your_turn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGame) Reset() {
+	*x = UserGame{}
+	mi := &file_proto_tictactoe_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGame) ProtoMessage() {}
+
+func (x *UserGame) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGame.ProtoReflect.Descriptor instead.
+func (*UserGame) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserGame) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *UserGame) GetYourTurn() bool {
+	if x != nil {
+		return x.YourTurn
+	}
+	return false
+}
+
+type SpectateGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateGameRequest) Reset() {
+	*x = SpectateGameRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateGameRequest) ProtoMessage() {}
+
+func (x *SpectateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateGameRequest.ProtoReflect.Descriptor instead.
+func (*SpectateGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SpectateGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SpectateGameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SpectateGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateGameResponse) Reset() {
+	*x = SpectateGameResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateGameResponse) ProtoMessage() {}
+
+func (x *SpectateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateGameResponse.ProtoReflect.Descriptor instead.
+func (*SpectateGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SpectateGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type SetSpectatorsAllowedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // must be the game's creator
+	Allowed       bool                   `protobuf:"varint,3,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSpectatorsAllowedRequest) Reset() {
+	*x = SetSpectatorsAllowedRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpectatorsAllowedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpectatorsAllowedRequest) ProtoMessage() {}
+
+func (x *SetSpectatorsAllowedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpectatorsAllowedRequest.ProtoReflect.Descriptor instead.
+func (*SetSpectatorsAllowedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetSpectatorsAllowedRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetSpectatorsAllowedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetSpectatorsAllowedRequest) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+type SetSpectatorsAllowedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSpectatorsAllowedResponse) Reset() {
+	*x = SetSpectatorsAllowedResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpectatorsAllowedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpectatorsAllowedResponse) ProtoMessage() {}
+
+func (x *SetSpectatorsAllowedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpectatorsAllowedResponse.ProtoReflect.Descriptor instead.
+func (*SetSpectatorsAllowedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetSpectatorsAllowedResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type ListLiveGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageToken     string                 `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // optional, next_page_token from a previous call
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // optional, defaults to 20, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLiveGamesRequest) Reset() {
+	*x = ListLiveGamesRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLiveGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLiveGamesRequest) ProtoMessage() {}
+
+func (x *ListLiveGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLiveGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListLiveGamesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListLiveGamesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLiveGamesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLiveGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`                                        // most watched first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLiveGamesResponse) Reset() {
+	*x = ListLiveGamesResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLiveGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLiveGamesResponse) ProtoMessage() {}
+
+func (x *ListLiveGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLiveGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListLiveGamesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListLiveGamesResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *ListLiveGamesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListLiveGamesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type OfferRematchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfferRematchRequest) Reset() {
+	*x = OfferRematchRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfferRematchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferRematchRequest) ProtoMessage() {}
+
+func (x *OfferRematchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferRematchRequest.ProtoReflect.Descriptor instead.
+func (*OfferRematchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OfferRematchRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *OfferRematchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type OfferRematchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfferRematchResponse) Reset() {
+	*x = OfferRematchResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfferRematchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferRematchResponse) ProtoMessage() {}
+
+func (x *OfferRematchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferRematchResponse.ProtoReflect.Descriptor instead.
+func (*OfferRematchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OfferRematchResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type AcceptRematchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // the finished game
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptRematchRequest) Reset() {
+	*x = AcceptRematchRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptRematchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptRematchRequest) ProtoMessage() {}
+
+func (x *AcceptRematchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptRematchRequest.ProtoReflect.Descriptor instead.
+func (*AcceptRematchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AcceptRematchRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AcceptRematchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AcceptRematchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"` // the new game
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptRematchResponse) Reset() {
+	*x = AcceptRematchResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptRematchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptRematchResponse) ProtoMessage() {}
+
+func (x *AcceptRematchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptRematchResponse.ProtoReflect.Descriptor instead.
+func (*AcceptRematchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AcceptRematchResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type DeclineRematchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineRematchRequest) Reset() {
+	*x = DeclineRematchRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineRematchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineRematchRequest) ProtoMessage() {}
+
+func (x *DeclineRematchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineRematchRequest.ProtoReflect.Descriptor instead.
+func (*DeclineRematchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeclineRematchRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *DeclineRematchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeclineRematchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineRematchResponse) Reset() {
+	*x = DeclineRematchResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineRematchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineRematchResponse) ProtoMessage() {}
+
+func (x *DeclineRematchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineRematchResponse.ProtoReflect.Descriptor instead.
+func (*DeclineRematchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeclineRematchResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type OfferDrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfferDrawRequest) Reset() {
+	*x = OfferDrawRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfferDrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferDrawRequest) ProtoMessage() {}
+
+func (x *OfferDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferDrawRequest.ProtoReflect.Descriptor instead.
+func (*OfferDrawRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OfferDrawRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *OfferDrawRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type OfferDrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfferDrawResponse) Reset() {
+	*x = OfferDrawResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfferDrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferDrawResponse) ProtoMessage() {}
+
+func (x *OfferDrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferDrawResponse.ProtoReflect.Descriptor instead.
+func (*OfferDrawResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OfferDrawResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type AcceptDrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptDrawRequest) Reset() {
+	*x = AcceptDrawRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptDrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptDrawRequest) ProtoMessage() {}
+
+func (x *AcceptDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptDrawRequest.ProtoReflect.Descriptor instead.
+func (*AcceptDrawRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AcceptDrawRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AcceptDrawRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AcceptDrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptDrawResponse) Reset() {
+	*x = AcceptDrawResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptDrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptDrawResponse) ProtoMessage() {}
+
+func (x *AcceptDrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptDrawResponse.ProtoReflect.Descriptor instead.
+func (*AcceptDrawResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AcceptDrawResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type DeclineDrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineDrawRequest) Reset() {
+	*x = DeclineDrawRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineDrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineDrawRequest) ProtoMessage() {}
+
+func (x *DeclineDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineDrawRequest.ProtoReflect.Descriptor instead.
+func (*DeclineDrawRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeclineDrawRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *DeclineDrawRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeclineDrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineDrawResponse) Reset() {
+	*x = DeclineDrawResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineDrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineDrawResponse) ProtoMessage() {}
+
+func (x *DeclineDrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineDrawResponse.ProtoReflect.Descriptor instead.
+func (*DeclineDrawResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeclineDrawResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type RequestTakebackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestTakebackRequest) Reset() {
+	*x = RequestTakebackRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestTakebackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestTakebackRequest) ProtoMessage() {}
+
+func (x *RequestTakebackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestTakebackRequest.ProtoReflect.Descriptor instead.
+func (*RequestTakebackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RequestTakebackRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RequestTakebackRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RequestTakebackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestTakebackResponse) Reset() {
+	*x = RequestTakebackResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestTakebackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestTakebackResponse) ProtoMessage() {}
+
+func (x *RequestTakebackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestTakebackResponse.ProtoReflect.Descriptor instead.
+func (*RequestTakebackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RequestTakebackResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type RespondTakebackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Accept        bool                   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondTakebackRequest) Reset() {
+	*x = RespondTakebackRequest{}
+	mi := &file_proto_tictactoe_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondTakebackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondTakebackRequest) ProtoMessage() {}
+
+func (x *RespondTakebackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondTakebackRequest.ProtoReflect.Descriptor instead.
+func (*RespondTakebackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RespondTakebackRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RespondTakebackRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RespondTakebackRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondTakebackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondTakebackResponse) Reset() {
+	*x = RespondTakebackResponse{}
+	mi := &file_proto_tictactoe_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondTakebackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondTakebackResponse) ProtoMessage() {}
+
+func (x *RespondTakebackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondTakebackResponse.ProtoReflect.Descriptor instead.
+func (*RespondTakebackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RespondTakebackResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type Game struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Player1Id string                 `protobuf:"bytes,2,opt,name=player1_id,json=player1Id,proto3" json:"player1_id,omitempty"`
+	Player2Id string                 `protobuf:"bytes,3,opt,name=player2_id,json=player2Id,proto3" json:"player2_id,omitempty"`
+	// flattened row by row, board_width cells per row: a player's symbol, "#"
+	// for a blocked cell, or empty
+	Board               []string       `protobuf:"bytes,4,rep,name=board,proto3" json:"board,omitempty"`
+	BoardSize           int32          `protobuf:"varint,5,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"` // set for square boards only
+	WinningLength       int32          `protobuf:"varint,6,opt,name=winning_length,json=winningLength,proto3" json:"winning_length,omitempty"`
+	Status              GameStatus     `protobuf:"varint,7,opt,name=status,proto3,enum=tictactoe.GameStatus" json:"status,omitempty"`
+	CurrentPlayerId     string         `protobuf:"bytes,8,opt,name=current_player_id,json=currentPlayerId,proto3" json:"current_player_id,omitempty"`
+	WinnerId            string         `protobuf:"bytes,9,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	CreatedAt           int64          `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           int64          `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Private             bool           `protobuf:"varint,12,opt,name=private,proto3" json:"private,omitempty"`
+	JoinCode            string         `protobuf:"bytes,13,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	InvitedUserId       string         `protobuf:"bytes,14,opt,name=invited_user_id,json=invitedUserId,proto3" json:"invited_user_id,omitempty"`
+	SpectatorsAllowed   bool           `protobuf:"varint,15,opt,name=spectators_allowed,json=spectatorsAllowed,proto3" json:"spectators_allowed,omitempty"`
+	SpectatorCount      int32          `protobuf:"varint,16,opt,name=spectator_count,json=spectatorCount,proto3" json:"spectator_count,omitempty"`
+	Rematch             *Rematch       `protobuf:"bytes,17,opt,name=rematch,proto3" json:"rematch,omitempty"`
+	DrawOfferedBy       string         `protobuf:"bytes,18,opt,name=draw_offered_by,json=drawOfferedBy,proto3" json:"draw_offered_by,omitempty"`                   // player with an open draw offer
+	Ranked              bool           `protobuf:"varint,19,opt,name=ranked,proto3" json:"ranked,omitempty"`                                                       // ranked games allow no takebacks
+	Moves               []*Move        `protobuf:"bytes,20,rep,name=moves,proto3" json:"moves,omitempty"`                                                          // oldest first
+	TakebackRequestedBy string         `protobuf:"bytes,21,opt,name=takeback_requested_by,json=takebackRequestedBy,proto3" json:"takeback_requested_by,omitempty"` // player with an open takeback request
+	Takebacks           []*Takeback    `protobuf:"bytes,22,rep,name=takebacks,proto3" json:"takebacks,omitempty"`                                                  // granted takebacks, oldest first
+	Variant             string         `protobuf:"bytes,23,opt,name=variant,proto3" json:"variant,omitempty"`                                                      // the rules the game is played by
+	BoardWidth          int32          `protobuf:"varint,24,opt,name=board_width,json=boardWidth,proto3" json:"board_width,omitempty"`
+	BoardHeight         int32          `protobuf:"varint,25,opt,name=board_height,json=boardHeight,proto3" json:"board_height,omitempty"`
+	Ultimate            *UltimateState `protobuf:"bytes,26,opt,name=ultimate,proto3" json:"ultimate,omitempty"` // set for ultimate games only
+	// everyone seated, in turn order, starting with player1_id and player2_id;
+	// seats 1 to 4 play X, O, Y and Z
+	PlayerIds      []string    `protobuf:"bytes,27,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	Seats          int32       `protobuf:"varint,28,opt,name=seats,proto3" json:"seats,omitempty"`                                        // number of players the game starts with
+	HandicapStones []*Position `protobuf:"bytes,29,rep,name=handicap_stones,json=handicapStones,proto3" json:"handicap_stones,omitempty"` // the second player's pre-placed stones
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	mi := &file_proto_tictactoe_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Game) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Game) GetPlayer1Id() string {
+	if x != nil {
+		return x.Player1Id
+	}
+	return ""
+}
+
+func (x *Game) GetPlayer2Id() string {
+	if x != nil {
+		return x.Player2Id
+	}
+	return ""
+}
+
+func (x *Game) GetBoard() []string {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *Game) GetBoardSize() int32 {
+	if x != nil {
+		return x.BoardSize
+	}
+	return 0
+}
+
+func (x *Game) GetWinningLength() int32 {
+	if x != nil {
+		return x.WinningLength
+	}
+	return 0
+}
+
+func (x *Game) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_PENDING
+}
+
+func (x *Game) GetCurrentPlayerId() string {
+	if x != nil {
+		return x.CurrentPlayerId
+	}
+	return ""
+}
+
+func (x *Game) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *Game) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Game) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Game) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *Game) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+func (x *Game) GetInvitedUserId() string {
+	if x != nil {
+		return x.InvitedUserId
+	}
+	return ""
+}
+
+func (x *Game) GetSpectatorsAllowed() bool {
+	if x != nil {
+		return x.SpectatorsAllowed
+	}
+	return false
+}
+
+func (x *Game) GetSpectatorCount() int32 {
+	if x != nil {
+		return x.SpectatorCount
+	}
+	return 0
+}
+
+func (x *Game) GetRematch() *Rematch {
+	if x != nil {
+		return x.Rematch
+	}
+	return nil
+}
+
+func (x *Game) GetDrawOfferedBy() string {
+	if x != nil {
+		return x.DrawOfferedBy
+	}
+	return ""
+}
+
+func (x *Game) GetRanked() bool {
+	if x != nil {
+		return x.Ranked
+	}
+	return false
+}
+
+func (x *Game) GetMoves() []*Move {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *Game) GetTakebackRequestedBy() string {
+	if x != nil {
+		return x.TakebackRequestedBy
+	}
+	return ""
+}
+
+func (x *Game) GetTakebacks() []*Takeback {
+	if x != nil {
+		return x.Takebacks
+	}
+	return nil
+}
+
+func (x *Game) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *Game) GetBoardWidth() int32 {
+	if x != nil {
+		return x.BoardWidth
+	}
+	return 0
+}
+
+func (x *Game) GetBoardHeight() int32 {
+	if x != nil {
+		return x.BoardHeight
+	}
+	return 0
+}
+
+func (x *Game) GetUltimate() *UltimateState {
+	if x != nil {
+		return x.Ultimate
+	}
+	return nil
+}
+
+func (x *Game) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *Game) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *Game) GetHandicapStones() []*Position {
+	if x != nil {
+		return x.HandicapStones
+	}
+	return nil
+}
+
+type Position struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Col           int32                  `protobuf:"varint,2,opt,name=col,proto3" json:"col,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_proto_tictactoe_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[39]
 	if x != nil {
-		return x.WinningLength
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Position) GetRow() int32 {
+	if x != nil {
+		return x.Row
 	}
 	return 0
 }
 
-func (x *Game) GetStatus() GameStatus {
+func (x *Position) GetCol() int32 {
 	if x != nil {
-		return x.Status
+		return x.Col
 	}
-	return GameStatus_PENDING
+	return 0
 }
 
-func (x *Game) GetCurrentPlayerId() string {
+// UltimateState is the meta-board of an ultimate game, whose board is nine
+// 3x3 local boards.
+type UltimateState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 9 cells, row by row, one per local board: the symbol of the player who
+	// won it, "-" once it is full without a winner, or empty while undecided
+	MetaBoard []string `protobuf:"bytes,1,rep,name=meta_board,json=metaBoard,proto3" json:"meta_board,omitempty"`
+	// index into meta_board of the local board the next move must be played
+	// in; unset if any undecided local board may be played
+	ForcedBoard   *int32 `protobuf:"varint,2,opt,name=forced_board,json=forcedBoard,proto3,oneof" json:"forced_board,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UltimateState) Reset() {
+	*x = UltimateState{}
+	mi := &file_proto_tictactoe_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UltimateState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UltimateState) ProtoMessage() {}
+
+func (x *UltimateState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[40]
 	if x != nil {
-		return x.CurrentPlayerId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UltimateState.ProtoReflect.Descriptor instead.
+func (*UltimateState) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UltimateState) GetMetaBoard() []string {
+	if x != nil {
+		return x.MetaBoard
+	}
+	return nil
+}
+
+func (x *UltimateState) GetForcedBoard() int32 {
+	if x != nil && x.ForcedBoard != nil {
+		return *x.ForcedBoard
+	}
+	return 0
+}
+
+type Move struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Row           int32                  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Col           int32                  `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+	PlayedAt      int64                  `protobuf:"varint,4,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Move) Reset() {
+	*x = Move{}
+	mi := &file_proto_tictactoe_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Move) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Move) ProtoMessage() {}
+
+func (x *Move) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Move.ProtoReflect.Descriptor instead.
+func (*Move) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Move) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *Game) GetWinnerId() string {
+func (x *Move) GetRow() int32 {
 	if x != nil {
-		return x.WinnerId
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Move) GetCol() int32 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *Move) GetPlayedAt() int64 {
+	if x != nil {
+		return x.PlayedAt
+	}
+	return 0
+}
+
+type Takeback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestedBy   string                 `protobuf:"bytes,1,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Moves         []*Move                `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves,omitempty"` // retracted plies, most recent first
+	GrantedAt     int64                  `protobuf:"varint,3,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Takeback) Reset() {
+	*x = Takeback{}
+	mi := &file_proto_tictactoe_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Takeback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Takeback) ProtoMessage() {}
+
+func (x *Takeback) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Takeback.ProtoReflect.Descriptor instead.
+func (*Takeback) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Takeback) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
 	}
 	return ""
 }
 
-func (x *Game) GetCreatedAt() int64 {
+func (x *Takeback) GetMoves() []*Move {
 	if x != nil {
-		return x.CreatedAt
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *Takeback) GetGrantedAt() int64 {
+	if x != nil {
+		return x.GrantedAt
 	}
 	return 0
 }
 
-func (x *Game) GetUpdatedAt() int64 {
+type Rematch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        RematchStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=tictactoe.RematchStatus" json:"status,omitempty"`
+	OfferedBy     string                 `protobuf:"bytes,2,opt,name=offered_by,json=offeredBy,proto3" json:"offered_by,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	GameId        string                 `protobuf:"bytes,4,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // the new game once accepted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rematch) Reset() {
+	*x = Rematch{}
+	mi := &file_proto_tictactoe_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rematch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rematch) ProtoMessage() {}
+
+func (x *Rematch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[43]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rematch.ProtoReflect.Descriptor instead.
+func (*Rematch) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Rematch) GetStatus() RematchStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RematchStatus_REMATCH_NONE
+}
+
+func (x *Rematch) GetOfferedBy() string {
+	if x != nil {
+		return x.OfferedBy
+	}
+	return ""
+}
+
+func (x *Rematch) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return 0
 }
 
+func (x *Rematch) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
 type UserStats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Wins          int32                  `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
-	Losses        int32                  `protobuf:"varint,3,opt,name=losses,proto3" json:"losses,omitempty"`
-	Draws         int32                  `protobuf:"varint,4,opt,name=draws,proto3" json:"draws,omitempty"`
-	TotalGames    int32                  `protobuf:"varint,5,opt,name=total_games,json=totalGames,proto3" json:"total_games,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	UserId        string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Wins          int32                    `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses        int32                    `protobuf:"varint,3,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws         int32                    `protobuf:"varint,4,opt,name=draws,proto3" json:"draws,omitempty"`
+	TotalGames    int32                    `protobuf:"varint,5,opt,name=total_games,json=totalGames,proto3" json:"total_games,omitempty"`
+	Variants      map[string]*VariantStats `protobuf:"bytes,6,rep,name=variants,proto3" json:"variants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // results per game variant, keyed by variant name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserStats) Reset() {
 	*x = UserStats{}
-	mi := &file_proto_tictactoe_proto_msgTypes[14]
+	mi := &file_proto_tictactoe_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -943,7 +3041,7 @@ func (x *UserStats) String() string {
 func (*UserStats) ProtoMessage() {}
 
 func (x *UserStats) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tictactoe_proto_msgTypes[14]
+	mi := &file_proto_tictactoe_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +3054,7 @@ func (x *UserStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
 func (*UserStats) Descriptor() ([]byte, []int) {
-	return file_proto_tictactoe_proto_rawDescGZIP(), []int{14}
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *UserStats) GetUserId() string {
@@ -994,26 +3092,134 @@ func (x *UserStats) GetTotalGames() int32 {
 	return 0
 }
 
+func (x *UserStats) GetVariants() map[string]*VariantStats {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type VariantStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wins          int32                  `protobuf:"varint,1,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses        int32                  `protobuf:"varint,2,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws         int32                  `protobuf:"varint,3,opt,name=draws,proto3" json:"draws,omitempty"`
+	TotalGames    int32                  `protobuf:"varint,4,opt,name=total_games,json=totalGames,proto3" json:"total_games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantStats) Reset() {
+	*x = VariantStats{}
+	mi := &file_proto_tictactoe_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantStats) ProtoMessage() {}
+
+func (x *VariantStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tictactoe_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantStats.ProtoReflect.Descriptor instead.
+func (*VariantStats) Descriptor() ([]byte, []int) {
+	return file_proto_tictactoe_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *VariantStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *VariantStats) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *VariantStats) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *VariantStats) GetTotalGames() int32 {
+	if x != nil {
+		return x.TotalGames
+	}
+	return 0
+}
+
 var File_proto_tictactoe_proto protoreflect.FileDescriptor
 
 const file_proto_tictactoe_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/tictactoe.proto\x12\ttictactoe\"q\n" +
+	"\x15proto/tictactoe.proto\x12\ttictactoe\x1a\x1cgoogle/api/annotations.proto\"\xeb\x03\n" +
 	"\x10StartGameRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"board_size\x18\x02 \x01(\x05R\tboardSize\x12%\n" +
-	"\x0ewinning_length\x18\x03 \x01(\x05R\rwinningLength\"u\n" +
+	"\x0ewinning_length\x18\x03 \x01(\x05R\rwinningLength\x12\x18\n" +
+	"\aprivate\x18\x04 \x01(\bR\aprivate\x12&\n" +
+	"\x0finvited_user_id\x18\x05 \x01(\tR\rinvitedUserId\x12\x18\n" +
+	"\avariant\x18\x06 \x01(\tR\avariant\x12\x1f\n" +
+	"\vboard_width\x18\a \x01(\x05R\n" +
+	"boardWidth\x12!\n" +
+	"\fboard_height\x18\b \x01(\x05R\vboardHeight\x12\x14\n" +
+	"\x05seats\x18\t \x01(\x05R\x05seats\x121\n" +
+	"\tobstacles\x18\n" +
+	" \x03(\v2\x13.tictactoe.PositionR\tobstacles\x12)\n" +
+	"\x10random_obstacles\x18\v \x01(\x05R\x0frandomObstacles\x12<\n" +
+	"\x0fhandicap_stones\x18\f \x03(\v2\x13.tictactoe.PositionR\x0ehandicapStones\x12\x1b\n" +
+	"\x06ranked\x18\r \x01(\bH\x00R\x06ranked\x88\x01\x01B\t\n" +
+	"\a_ranked\"\x92\x01\n" +
 	"\x11StartGameResponse\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12-\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x15.tictactoe.GameStatusR\x06status\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"a\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1b\n" +
+	"\tjoin_code\x18\x04 \x01(\tR\bjoinCode\"\xd1\x03\n" +
 	"\x19SearchPendingGamesRequest\x12\x1d\n" +
 	"\n" +
 	"board_size\x18\x01 \x01(\x05R\tboardSize\x12%\n" +
-	"\x0ewinning_length\x18\x02 \x01(\x05R\rwinningLength\"J\n" +
+	"\x0ewinning_length\x18\x02 \x01(\x05R\rwinningLength\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\tR\tcreatorId\x12&\n" +
+	"\x0fmax_age_seconds\x18\x04 \x01(\x03R\rmaxAgeSeconds\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x126\n" +
+	"\border_by\x18\x06 \x01(\x0e2\x1b.tictactoe.PendingGameOrderR\aorderBy\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\x05R\bpageSize\x12\x18\n" +
+	"\avariant\x18\t \x01(\tR\avariant\x12\x1f\n" +
+	"\vboard_width\x18\n" +
+	" \x01(\x05R\n" +
+	"boardWidth\x12!\n" +
+	"\fboard_height\x18\v \x01(\x05R\vboardHeight\x12\x14\n" +
+	"\x05seats\x18\f \x01(\x05R\x05seats\x12\x1b\n" +
+	"\x06ranked\x18\r \x01(\bH\x00R\x06ranked\x88\x01\x01B\t\n" +
+	"\a_ranked\"\x91\x01\n" +
 	"\x1aSearchPendingGamesResponse\x12,\n" +
-	"\x05games\x18\x01 \x03(\v2\x16.tictactoe.PendingGameR\x05games\"\xaa\x01\n" +
+	"\x05games\x18\x01 \x03(\v2\x16.tictactoe.PendingGameR\x05games\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\x9c\x03\n" +
 	"\vPendingGame\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1d\n" +
 	"\n" +
@@ -1022,10 +3228,22 @@ const file_proto_tictactoe_proto_rawDesc = "" +
 	"board_size\x18\x03 \x01(\x05R\tboardSize\x12%\n" +
 	"\x0ewinning_length\x18\x04 \x01(\x05R\rwinningLength\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"C\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x18\n" +
+	"\avariant\x18\x06 \x01(\tR\avariant\x12\x1f\n" +
+	"\vboard_width\x18\a \x01(\x05R\n" +
+	"boardWidth\x12!\n" +
+	"\fboard_height\x18\b \x01(\x05R\vboardHeight\x12\x14\n" +
+	"\x05seats\x18\t \x01(\x05R\x05seats\x12\x1d\n" +
+	"\n" +
+	"open_seats\x18\n" +
+	" \x01(\x05R\topenSeats\x12\x1c\n" +
+	"\tobstacles\x18\v \x01(\x05R\tobstacles\x12'\n" +
+	"\x0fhandicap_stones\x18\f \x01(\x05R\x0ehandicapStones\x12\x16\n" +
+	"\x06ranked\x18\r \x01(\bR\x06ranked\"`\n" +
 	"\x0fJoinGameRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\agame_id\x18\x02 \x01(\tR\x06gameId\"\x80\x01\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tjoin_code\x18\x03 \x01(\tR\bjoinCode\"\x80\x01\n" +
 	"\x10JoinGameResponse\x12-\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x15.tictactoe.GameStatusR\x06status\x12#\n" +
 	"\x04game\x18\x02 \x01(\v2\x0f.tictactoe.GameR\x04game\x12\x18\n" +
@@ -1047,7 +3265,80 @@ const file_proto_tictactoe_proto_rawDesc = "" +
 	"\x13GetUserStatsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"B\n" +
 	"\x14GetUserStatsResponse\x12*\n" +
-	"\x05stats\x18\x01 \x01(\v2\x14.tictactoe.UserStatsR\x05stats\"\xe6\x02\n" +
+	"\x05stats\x18\x01 \x01(\v2\x14.tictactoe.UserStatsR\x05stats\"\xa7\x01\n" +
+	"\x14ListUserGamesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12:\n" +
+	"\rstatus_filter\x18\x02 \x03(\x0e2\x15.tictactoe.GameStatusR\fstatusFilter\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"j\n" +
+	"\x15ListUserGamesResponse\x12)\n" +
+	"\x05games\x18\x01 \x03(\v2\x13.tictactoe.UserGameR\x05games\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"L\n" +
+	"\bUserGame\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\x12\x1b\n" +
+	"\tyour_turn\x18\x02 \x01(\bR\byourTurn\"G\n" +
+	"\x13SpectateGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\";\n" +
+	"\x14SpectateGameResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\"i\n" +
+	"\x1bSetSpectatorsAllowedRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\aallowed\x18\x03 \x01(\bR\aallowed\"C\n" +
+	"\x1cSetSpectatorsAllowedResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\"R\n" +
+	"\x14ListLiveGamesRequest\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x01 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x85\x01\n" +
+	"\x15ListLiveGamesResponse\x12%\n" +
+	"\x05games\x18\x01 \x03(\v2\x0f.tictactoe.GameR\x05games\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"G\n" +
+	"\x13OfferRematchRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\";\n" +
+	"\x14OfferRematchResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\"H\n" +
+	"\x14AcceptRematchRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"<\n" +
+	"\x15AcceptRematchResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\"I\n" +
+	"\x15DeclineRematchRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"=\n" +
+	"\x16DeclineRematchResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\"D\n" +
+	"\x10OfferDrawRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"8\n" +
+	"\x11OfferDrawResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\"E\n" +
+	"\x11AcceptDrawRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"9\n" +
+	"\x12AcceptDrawResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\"F\n" +
+	"\x12DeclineDrawRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\":\n" +
+	"\x13DeclineDrawResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\"J\n" +
+	"\x16RequestTakebackRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\">\n" +
+	"\x17RequestTakebackResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\"b\n" +
+	"\x16RespondTakebackRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06accept\x18\x03 \x01(\bR\x06accept\">\n" +
+	"\x17RespondTakebackResponse\x12#\n" +
+	"\x04game\x18\x01 \x01(\v2\x0f.tictactoe.GameR\x04game\"\xa0\b\n" +
 	"\x04Game\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1065,28 +3356,106 @@ const file_proto_tictactoe_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\x03R\tupdatedAt\"\x87\x01\n" +
+	"updated_at\x18\v \x01(\x03R\tupdatedAt\x12\x18\n" +
+	"\aprivate\x18\f \x01(\bR\aprivate\x12\x1b\n" +
+	"\tjoin_code\x18\r \x01(\tR\bjoinCode\x12&\n" +
+	"\x0finvited_user_id\x18\x0e \x01(\tR\rinvitedUserId\x12-\n" +
+	"\x12spectators_allowed\x18\x0f \x01(\bR\x11spectatorsAllowed\x12'\n" +
+	"\x0fspectator_count\x18\x10 \x01(\x05R\x0espectatorCount\x12,\n" +
+	"\arematch\x18\x11 \x01(\v2\x12.tictactoe.RematchR\arematch\x12&\n" +
+	"\x0fdraw_offered_by\x18\x12 \x01(\tR\rdrawOfferedBy\x12\x16\n" +
+	"\x06ranked\x18\x13 \x01(\bR\x06ranked\x12%\n" +
+	"\x05moves\x18\x14 \x03(\v2\x0f.tictactoe.MoveR\x05moves\x122\n" +
+	"\x15takeback_requested_by\x18\x15 \x01(\tR\x13takebackRequestedBy\x121\n" +
+	"\ttakebacks\x18\x16 \x03(\v2\x13.tictactoe.TakebackR\ttakebacks\x12\x18\n" +
+	"\avariant\x18\x17 \x01(\tR\avariant\x12\x1f\n" +
+	"\vboard_width\x18\x18 \x01(\x05R\n" +
+	"boardWidth\x12!\n" +
+	"\fboard_height\x18\x19 \x01(\x05R\vboardHeight\x124\n" +
+	"\bultimate\x18\x1a \x01(\v2\x18.tictactoe.UltimateStateR\bultimate\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\x1b \x03(\tR\tplayerIds\x12\x14\n" +
+	"\x05seats\x18\x1c \x01(\x05R\x05seats\x12<\n" +
+	"\x0fhandicap_stones\x18\x1d \x03(\v2\x13.tictactoe.PositionR\x0ehandicapStones\".\n" +
+	"\bPosition\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x10\n" +
+	"\x03col\x18\x02 \x01(\x05R\x03col\"g\n" +
+	"\rUltimateState\x12\x1d\n" +
+	"\n" +
+	"meta_board\x18\x01 \x03(\tR\tmetaBoard\x12&\n" +
+	"\fforced_board\x18\x02 \x01(\x05H\x00R\vforcedBoard\x88\x01\x01B\x0f\n" +
+	"\r_forced_board\"d\n" +
+	"\x04Move\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x10\n" +
+	"\x03row\x18\x02 \x01(\x05R\x03row\x12\x10\n" +
+	"\x03col\x18\x03 \x01(\x05R\x03col\x12\x1b\n" +
+	"\tplayed_at\x18\x04 \x01(\x03R\bplayedAt\"s\n" +
+	"\bTakeback\x12!\n" +
+	"\frequested_by\x18\x01 \x01(\tR\vrequestedBy\x12%\n" +
+	"\x05moves\x18\x02 \x03(\v2\x0f.tictactoe.MoveR\x05moves\x12\x1d\n" +
+	"\n" +
+	"granted_at\x18\x03 \x01(\x03R\tgrantedAt\"\x92\x01\n" +
+	"\aRematch\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x18.tictactoe.RematchStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"offered_by\x18\x02 \x01(\tR\tofferedBy\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x17\n" +
+	"\agame_id\x18\x04 \x01(\tR\x06gameId\"\x9d\x02\n" +
 	"\tUserStats\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04wins\x18\x02 \x01(\x05R\x04wins\x12\x16\n" +
 	"\x06losses\x18\x03 \x01(\x05R\x06losses\x12\x14\n" +
 	"\x05draws\x18\x04 \x01(\x05R\x05draws\x12\x1f\n" +
 	"\vtotal_games\x18\x05 \x01(\x05R\n" +
-	"totalGames*^\n" +
+	"totalGames\x12>\n" +
+	"\bvariants\x18\x06 \x03(\v2\".tictactoe.UserStats.VariantsEntryR\bvariants\x1aT\n" +
+	"\rVariantsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.tictactoe.VariantStatsR\x05value:\x028\x01\"q\n" +
+	"\fVariantStats\x12\x12\n" +
+	"\x04wins\x18\x01 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x02 \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\x03 \x01(\x05R\x05draws\x12\x1f\n" +
+	"\vtotal_games\x18\x04 \x01(\x05R\n" +
+	"totalGames*Z\n" +
+	"\x10PendingGameOrder\x12\x16\n" +
+	"\x12ORDER_OLDEST_FIRST\x10\x00\x12\x16\n" +
+	"\x12ORDER_NEWEST_FIRST\x10\x01\x12\x16\n" +
+	"\x12ORDER_CREATOR_WINS\x10\x02*w\n" +
+	"\rRematchStatus\x12\x10\n" +
+	"\fREMATCH_NONE\x10\x00\x12\x13\n" +
+	"\x0fREMATCH_OFFERED\x10\x01\x12\x14\n" +
+	"\x10REMATCH_ACCEPTED\x10\x02\x12\x14\n" +
+	"\x10REMATCH_DECLINED\x10\x03\x12\x13\n" +
+	"\x0fREMATCH_EXPIRED\x10\x04*^\n" +
 	"\n" +
 	"GameStatus\x12\v\n" +
 	"\aPENDING\x10\x00\x12\x0f\n" +
 	"\vIN_PROGRESS\x10\x01\x12\x10\n" +
 	"\fFINISHED_WIN\x10\x02\x12\x11\n" +
 	"\rFINISHED_DRAW\x10\x03\x12\r\n" +
-	"\tABANDONED\x10\x042\xda\x03\n" +
-	"\x10TicTacToeService\x12F\n" +
-	"\tStartGame\x12\x1b.tictactoe.StartGameRequest\x1a\x1c.tictactoe.StartGameResponse\x12a\n" +
-	"\x12SearchPendingGames\x12$.tictactoe.SearchPendingGamesRequest\x1a%.tictactoe.SearchPendingGamesResponse\x12C\n" +
-	"\bJoinGame\x12\x1a.tictactoe.JoinGameRequest\x1a\x1b.tictactoe.JoinGameResponse\x12C\n" +
-	"\bMakeMove\x12\x1a.tictactoe.MakeMoveRequest\x1a\x1b.tictactoe.MakeMoveResponse\x12@\n" +
-	"\aGetGame\x12\x19.tictactoe.GetGameRequest\x1a\x1a.tictactoe.GetGameResponse\x12O\n" +
-	"\fGetUserStats\x12\x1e.tictactoe.GetUserStatsRequest\x1a\x1f.tictactoe.GetUserStatsResponseB\x11Z\x0ftictactoe/protob\x06proto3"
+	"\tABANDONED\x10\x042\xaa\x11\n" +
+	"\x10TicTacToeService\x12\\\n" +
+	"\tStartGame\x12\x1b.tictactoe.StartGameRequest\x1a\x1c.tictactoe.StartGameResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/games\x12|\n" +
+	"\x12SearchPendingGames\x12$.tictactoe.SearchPendingGamesRequest\x1a%.tictactoe.SearchPendingGamesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/pending-games\x12\x8e\x01\n" +
+	"\bJoinGame\x12\x1a.tictactoe.JoinGameRequest\x1a\x1b.tictactoe.JoinGameResponse\"I\x82\xd3\xe4\x93\x02C:\x01*Z$:\x01*\"\x1f/v1/join-codes/{join_code}/join\"\x18/v1/games/{game_id}/join\x12i\n" +
+	"\bMakeMove\x12\x1a.tictactoe.MakeMoveRequest\x1a\x1b.tictactoe.MakeMoveResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/games/{game_id}/moves\x12]\n" +
+	"\aGetGame\x12\x19.tictactoe.GetGameRequest\x1a\x1a.tictactoe.GetGameResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/games/{game_id}\x12r\n" +
+	"\fGetUserStats\x12\x1e.tictactoe.GetUserStatsRequest\x1a\x1f.tictactoe.GetUserStatsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/users/{user_id}/stats\x12u\n" +
+	"\rListUserGames\x12\x1f.tictactoe.ListUserGamesRequest\x1a .tictactoe.ListUserGamesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/users/{user_id}/games\x12w\n" +
+	"\fSpectateGame\x12\x1e.tictactoe.SpectateGameRequest\x1a\x1f.tictactoe.SpectateGameResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/games/{game_id}/spectate0\x01\x12\x9a\x01\n" +
+	"\x14SetSpectatorsAllowed\x12&.tictactoe.SetSpectatorsAllowedRequest\x1a'.tictactoe.SetSpectatorsAllowedResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/games/{game_id}/spectators-allowed\x12j\n" +
+	"\rListLiveGames\x12\x1f.tictactoe.ListLiveGamesRequest\x1a .tictactoe.ListLiveGamesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/live-games\x12w\n" +
+	"\fOfferRematch\x12\x1e.tictactoe.OfferRematchRequest\x1a\x1f.tictactoe.OfferRematchResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/games/{game_id}/rematch\x12\x81\x01\n" +
+	"\rAcceptRematch\x12\x1f.tictactoe.AcceptRematchRequest\x1a .tictactoe.AcceptRematchResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/games/{game_id}/rematch/accept\x12\x85\x01\n" +
+	"\x0eDeclineRematch\x12 .tictactoe.DeclineRematchRequest\x1a!.tictactoe.DeclineRematchResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/games/{game_id}/rematch/decline\x12k\n" +
+	"\tOfferDraw\x12\x1b.tictactoe.OfferDrawRequest\x1a\x1c.tictactoe.OfferDrawResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/games/{game_id}/draw\x12u\n" +
+	"\n" +
+	"AcceptDraw\x12\x1c.tictactoe.AcceptDrawRequest\x1a\x1d.tictactoe.AcceptDrawResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/games/{game_id}/draw/accept\x12y\n" +
+	"\vDeclineDraw\x12\x1d.tictactoe.DeclineDrawRequest\x1a\x1e.tictactoe.DeclineDrawResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/games/{game_id}/draw/decline\x12\x81\x01\n" +
+	"\x0fRequestTakeback\x12!.tictactoe.RequestTakebackRequest\x1a\".tictactoe.RequestTakebackResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/games/{game_id}/takeback\x12\x89\x01\n" +
+	"\x0fRespondTakeback\x12!.tictactoe.RespondTakebackRequest\x1a\".tictactoe.RespondTakebackResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/games/{game_id}/takeback/respondB\x11Z\x0ftictactoe/protob\x06proto3"
 
 var (
 	file_proto_tictactoe_proto_rawDescOnce sync.Once
@@ -1100,53 +3469,137 @@ func file_proto_tictactoe_proto_rawDescGZIP() []byte {
 	return file_proto_tictactoe_proto_rawDescData
 }
 
-var file_proto_tictactoe_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_tictactoe_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_proto_tictactoe_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_tictactoe_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_proto_tictactoe_proto_goTypes = []any{
-	(GameStatus)(0),                    // 0: tictactoe.GameStatus
-	(*StartGameRequest)(nil),           // 1: tictactoe.StartGameRequest
-	(*StartGameResponse)(nil),          // 2: tictactoe.StartGameResponse
-	(*SearchPendingGamesRequest)(nil),  // 3: tictactoe.SearchPendingGamesRequest
-	(*SearchPendingGamesResponse)(nil), // 4: tictactoe.SearchPendingGamesResponse
-	(*PendingGame)(nil),                // 5: tictactoe.PendingGame
-	(*JoinGameRequest)(nil),            // 6: tictactoe.JoinGameRequest
-	(*JoinGameResponse)(nil),           // 7: tictactoe.JoinGameResponse
-	(*MakeMoveRequest)(nil),            // 8: tictactoe.MakeMoveRequest
-	(*MakeMoveResponse)(nil),           // 9: tictactoe.MakeMoveResponse
-	(*GetGameRequest)(nil),             // 10: tictactoe.GetGameRequest
-	(*GetGameResponse)(nil),            // 11: tictactoe.GetGameResponse
-	(*GetUserStatsRequest)(nil),        // 12: tictactoe.GetUserStatsRequest
-	(*GetUserStatsResponse)(nil),       // 13: tictactoe.GetUserStatsResponse
-	(*Game)(nil),                       // 14: tictactoe.Game
-	(*UserStats)(nil),                  // 15: tictactoe.UserStats
+	(PendingGameOrder)(0),                // 0: tictactoe.PendingGameOrder
+	(RematchStatus)(0),                   // 1: tictactoe.RematchStatus
+	(GameStatus)(0),                      // 2: tictactoe.GameStatus
+	(*StartGameRequest)(nil),             // 3: tictactoe.StartGameRequest
+	(*StartGameResponse)(nil),            // 4: tictactoe.StartGameResponse
+	(*SearchPendingGamesRequest)(nil),    // 5: tictactoe.SearchPendingGamesRequest
+	(*SearchPendingGamesResponse)(nil),   // 6: tictactoe.SearchPendingGamesResponse
+	(*PendingGame)(nil),                  // 7: tictactoe.PendingGame
+	(*JoinGameRequest)(nil),              // 8: tictactoe.JoinGameRequest
+	(*JoinGameResponse)(nil),             // 9: tictactoe.JoinGameResponse
+	(*MakeMoveRequest)(nil),              // 10: tictactoe.MakeMoveRequest
+	(*MakeMoveResponse)(nil),             // 11: tictactoe.MakeMoveResponse
+	(*GetGameRequest)(nil),               // 12: tictactoe.GetGameRequest
+	(*GetGameResponse)(nil),              // 13: tictactoe.GetGameResponse
+	(*GetUserStatsRequest)(nil),          // 14: tictactoe.GetUserStatsRequest
+	(*GetUserStatsResponse)(nil),         // 15: tictactoe.GetUserStatsResponse
+	(*ListUserGamesRequest)(nil),         // 16: tictactoe.ListUserGamesRequest
+	(*ListUserGamesResponse)(nil),        // 17: tictactoe.ListUserGamesResponse
+	(*UserGame)(nil),                     // 18: tictactoe.UserGame
+	(*SpectateGameRequest)(nil),          // 19: tictactoe.SpectateGameRequest
+	(*SpectateGameResponse)(nil),         // 20: tictactoe.SpectateGameResponse
+	(*SetSpectatorsAllowedRequest)(nil),  // 21: tictactoe.SetSpectatorsAllowedRequest
+	(*SetSpectatorsAllowedResponse)(nil), // 22: tictactoe.SetSpectatorsAllowedResponse
+	(*ListLiveGamesRequest)(nil),         // 23: tictactoe.ListLiveGamesRequest
+	(*ListLiveGamesResponse)(nil),        // 24: tictactoe.ListLiveGamesResponse
+	(*OfferRematchRequest)(nil),          // 25: tictactoe.OfferRematchRequest
+	(*OfferRematchResponse)(nil),         // 26: tictactoe.OfferRematchResponse
+	(*AcceptRematchRequest)(nil),         // 27: tictactoe.AcceptRematchRequest
+	(*AcceptRematchResponse)(nil),        // 28: tictactoe.AcceptRematchResponse
+	(*DeclineRematchRequest)(nil),        // 29: tictactoe.DeclineRematchRequest
+	(*DeclineRematchResponse)(nil),       // 30: tictactoe.DeclineRematchResponse
+	(*OfferDrawRequest)(nil),             // 31: tictactoe.OfferDrawRequest
+	(*OfferDrawResponse)(nil),            // 32: tictactoe.OfferDrawResponse
+	(*AcceptDrawRequest)(nil),            // 33: tictactoe.AcceptDrawRequest
+	(*AcceptDrawResponse)(nil),           // 34: tictactoe.AcceptDrawResponse
+	(*DeclineDrawRequest)(nil),           // 35: tictactoe.DeclineDrawRequest
+	(*DeclineDrawResponse)(nil),          // 36: tictactoe.DeclineDrawResponse
+	(*RequestTakebackRequest)(nil),       // 37: tictactoe.RequestTakebackRequest
+	(*RequestTakebackResponse)(nil),      // 38: tictactoe.RequestTakebackResponse
+	(*RespondTakebackRequest)(nil),       // 39: tictactoe.RespondTakebackRequest
+	(*RespondTakebackResponse)(nil),      // 40: tictactoe.RespondTakebackResponse
+	(*Game)(nil),                         // 41: tictactoe.Game
+	(*Position)(nil),                     // 42: tictactoe.Position
+	(*UltimateState)(nil),                // 43: tictactoe.UltimateState
+	(*Move)(nil),                         // 44: tictactoe.Move
+	(*Takeback)(nil),                     // 45: tictactoe.Takeback
+	(*Rematch)(nil),                      // 46: tictactoe.Rematch
+	(*UserStats)(nil),                    // 47: tictactoe.UserStats
+	(*VariantStats)(nil),                 // 48: tictactoe.VariantStats
+	nil,                                  // 49: tictactoe.UserStats.VariantsEntry
 }
 var file_proto_tictactoe_proto_depIdxs = []int32{
-	0,  // 0: tictactoe.StartGameResponse.status:type_name -> tictactoe.GameStatus
-	5,  // 1: tictactoe.SearchPendingGamesResponse.games:type_name -> tictactoe.PendingGame
-	0,  // 2: tictactoe.JoinGameResponse.status:type_name -> tictactoe.GameStatus
-	14, // 3: tictactoe.JoinGameResponse.game:type_name -> tictactoe.Game
-	0,  // 4: tictactoe.MakeMoveResponse.status:type_name -> tictactoe.GameStatus
-	14, // 5: tictactoe.MakeMoveResponse.game:type_name -> tictactoe.Game
-	14, // 6: tictactoe.GetGameResponse.game:type_name -> tictactoe.Game
-	15, // 7: tictactoe.GetUserStatsResponse.stats:type_name -> tictactoe.UserStats
-	0,  // 8: tictactoe.Game.status:type_name -> tictactoe.GameStatus
-	1,  // 9: tictactoe.TicTacToeService.StartGame:input_type -> tictactoe.StartGameRequest
-	3,  // 10: tictactoe.TicTacToeService.SearchPendingGames:input_type -> tictactoe.SearchPendingGamesRequest
-	6,  // 11: tictactoe.TicTacToeService.JoinGame:input_type -> tictactoe.JoinGameRequest
-	8,  // 12: tictactoe.TicTacToeService.MakeMove:input_type -> tictactoe.MakeMoveRequest
-	10, // 13: tictactoe.TicTacToeService.GetGame:input_type -> tictactoe.GetGameRequest
-	12, // 14: tictactoe.TicTacToeService.GetUserStats:input_type -> tictactoe.GetUserStatsRequest
-	2,  // 15: tictactoe.TicTacToeService.StartGame:output_type -> tictactoe.StartGameResponse
-	4,  // 16: tictactoe.TicTacToeService.SearchPendingGames:output_type -> tictactoe.SearchPendingGamesResponse
-	7,  // 17: tictactoe.TicTacToeService.JoinGame:output_type -> tictactoe.JoinGameResponse
-	9,  // 18: tictactoe.TicTacToeService.MakeMove:output_type -> tictactoe.MakeMoveResponse
-	11, // 19: tictactoe.TicTacToeService.GetGame:output_type -> tictactoe.GetGameResponse
-	13, // 20: tictactoe.TicTacToeService.GetUserStats:output_type -> tictactoe.GetUserStatsResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	42, // 0: tictactoe.StartGameRequest.obstacles:type_name -> tictactoe.Position
+	42, // 1: tictactoe.StartGameRequest.handicap_stones:type_name -> tictactoe.Position
+	2,  // 2: tictactoe.StartGameResponse.status:type_name -> tictactoe.GameStatus
+	0,  // 3: tictactoe.SearchPendingGamesRequest.order_by:type_name -> tictactoe.PendingGameOrder
+	7,  // 4: tictactoe.SearchPendingGamesResponse.games:type_name -> tictactoe.PendingGame
+	2,  // 5: tictactoe.JoinGameResponse.status:type_name -> tictactoe.GameStatus
+	41, // 6: tictactoe.JoinGameResponse.game:type_name -> tictactoe.Game
+	2,  // 7: tictactoe.MakeMoveResponse.status:type_name -> tictactoe.GameStatus
+	41, // 8: tictactoe.MakeMoveResponse.game:type_name -> tictactoe.Game
+	41, // 9: tictactoe.GetGameResponse.game:type_name -> tictactoe.Game
+	47, // 10: tictactoe.GetUserStatsResponse.stats:type_name -> tictactoe.UserStats
+	2,  // 11: tictactoe.ListUserGamesRequest.status_filter:type_name -> tictactoe.GameStatus
+	18, // 12: tictactoe.ListUserGamesResponse.games:type_name -> tictactoe.UserGame
+	41, // 13: tictactoe.UserGame.game:type_name -> tictactoe.Game
+	41, // 14: tictactoe.SpectateGameResponse.game:type_name -> tictactoe.Game
+	41, // 15: tictactoe.SetSpectatorsAllowedResponse.game:type_name -> tictactoe.Game
+	41, // 16: tictactoe.ListLiveGamesResponse.games:type_name -> tictactoe.Game
+	41, // 17: tictactoe.OfferRematchResponse.game:type_name -> tictactoe.Game
+	41, // 18: tictactoe.AcceptRematchResponse.game:type_name -> tictactoe.Game
+	41, // 19: tictactoe.DeclineRematchResponse.game:type_name -> tictactoe.Game
+	41, // 20: tictactoe.OfferDrawResponse.game:type_name -> tictactoe.Game
+	41, // 21: tictactoe.AcceptDrawResponse.game:type_name -> tictactoe.Game
+	41, // 22: tictactoe.DeclineDrawResponse.game:type_name -> tictactoe.Game
+	41, // 23: tictactoe.RequestTakebackResponse.game:type_name -> tictactoe.Game
+	41, // 24: tictactoe.RespondTakebackResponse.game:type_name -> tictactoe.Game
+	2,  // 25: tictactoe.Game.status:type_name -> tictactoe.GameStatus
+	46, // 26: tictactoe.Game.rematch:type_name -> tictactoe.Rematch
+	44, // 27: tictactoe.Game.moves:type_name -> tictactoe.Move
+	45, // 28: tictactoe.Game.takebacks:type_name -> tictactoe.Takeback
+	43, // 29: tictactoe.Game.ultimate:type_name -> tictactoe.UltimateState
+	42, // 30: tictactoe.Game.handicap_stones:type_name -> tictactoe.Position
+	44, // 31: tictactoe.Takeback.moves:type_name -> tictactoe.Move
+	1,  // 32: tictactoe.Rematch.status:type_name -> tictactoe.RematchStatus
+	49, // 33: tictactoe.UserStats.variants:type_name -> tictactoe.UserStats.VariantsEntry
+	48, // 34: tictactoe.UserStats.VariantsEntry.value:type_name -> tictactoe.VariantStats
+	3,  // 35: tictactoe.TicTacToeService.StartGame:input_type -> tictactoe.StartGameRequest
+	5,  // 36: tictactoe.TicTacToeService.SearchPendingGames:input_type -> tictactoe.SearchPendingGamesRequest
+	8,  // 37: tictactoe.TicTacToeService.JoinGame:input_type -> tictactoe.JoinGameRequest
+	10, // 38: tictactoe.TicTacToeService.MakeMove:input_type -> tictactoe.MakeMoveRequest
+	12, // 39: tictactoe.TicTacToeService.GetGame:input_type -> tictactoe.GetGameRequest
+	14, // 40: tictactoe.TicTacToeService.GetUserStats:input_type -> tictactoe.GetUserStatsRequest
+	16, // 41: tictactoe.TicTacToeService.ListUserGames:input_type -> tictactoe.ListUserGamesRequest
+	19, // 42: tictactoe.TicTacToeService.SpectateGame:input_type -> tictactoe.SpectateGameRequest
+	21, // 43: tictactoe.TicTacToeService.SetSpectatorsAllowed:input_type -> tictactoe.SetSpectatorsAllowedRequest
+	23, // 44: tictactoe.TicTacToeService.ListLiveGames:input_type -> tictactoe.ListLiveGamesRequest
+	25, // 45: tictactoe.TicTacToeService.OfferRematch:input_type -> tictactoe.OfferRematchRequest
+	27, // 46: tictactoe.TicTacToeService.AcceptRematch:input_type -> tictactoe.AcceptRematchRequest
+	29, // 47: tictactoe.TicTacToeService.DeclineRematch:input_type -> tictactoe.DeclineRematchRequest
+	31, // 48: tictactoe.TicTacToeService.OfferDraw:input_type -> tictactoe.OfferDrawRequest
+	33, // 49: tictactoe.TicTacToeService.AcceptDraw:input_type -> tictactoe.AcceptDrawRequest
+	35, // 50: tictactoe.TicTacToeService.DeclineDraw:input_type -> tictactoe.DeclineDrawRequest
+	37, // 51: tictactoe.TicTacToeService.RequestTakeback:input_type -> tictactoe.RequestTakebackRequest
+	39, // 52: tictactoe.TicTacToeService.RespondTakeback:input_type -> tictactoe.RespondTakebackRequest
+	4,  // 53: tictactoe.TicTacToeService.StartGame:output_type -> tictactoe.StartGameResponse
+	6,  // 54: tictactoe.TicTacToeService.SearchPendingGames:output_type -> tictactoe.SearchPendingGamesResponse
+	9,  // 55: tictactoe.TicTacToeService.JoinGame:output_type -> tictactoe.JoinGameResponse
+	11, // 56: tictactoe.TicTacToeService.MakeMove:output_type -> tictactoe.MakeMoveResponse
+	13, // 57: tictactoe.TicTacToeService.GetGame:output_type -> tictactoe.GetGameResponse
+	15, // 58: tictactoe.TicTacToeService.GetUserStats:output_type -> tictactoe.GetUserStatsResponse
+	17, // 59: tictactoe.TicTacToeService.ListUserGames:output_type -> tictactoe.ListUserGamesResponse
+	20, // 60: tictactoe.TicTacToeService.SpectateGame:output_type -> tictactoe.SpectateGameResponse
+	22, // 61: tictactoe.TicTacToeService.SetSpectatorsAllowed:output_type -> tictactoe.SetSpectatorsAllowedResponse
+	24, // 62: tictactoe.TicTacToeService.ListLiveGames:output_type -> tictactoe.ListLiveGamesResponse
+	26, // 63: tictactoe.TicTacToeService.OfferRematch:output_type -> tictactoe.OfferRematchResponse
+	28, // 64: tictactoe.TicTacToeService.AcceptRematch:output_type -> tictactoe.AcceptRematchResponse
+	30, // 65: tictactoe.TicTacToeService.DeclineRematch:output_type -> tictactoe.DeclineRematchResponse
+	32, // 66: tictactoe.TicTacToeService.OfferDraw:output_type -> tictactoe.OfferDrawResponse
+	34, // 67: tictactoe.TicTacToeService.AcceptDraw:output_type -> tictactoe.AcceptDrawResponse
+	36, // 68: tictactoe.TicTacToeService.DeclineDraw:output_type -> tictactoe.DeclineDrawResponse
+	38, // 69: tictactoe.TicTacToeService.RequestTakeback:output_type -> tictactoe.RequestTakebackResponse
+	40, // 70: tictactoe.TicTacToeService.RespondTakeback:output_type -> tictactoe.RespondTakebackResponse
+	53, // [53:71] is the sub-list for method output_type
+	35, // [35:53] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_proto_tictactoe_proto_init() }
@@ -1154,13 +3607,16 @@ func file_proto_tictactoe_proto_init() {
 	if File_proto_tictactoe_proto != nil {
 		return
 	}
+	file_proto_tictactoe_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_tictactoe_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_tictactoe_proto_msgTypes[40].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_tictactoe_proto_rawDesc), len(file_proto_tictactoe_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      3,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
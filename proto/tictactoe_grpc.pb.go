@@ -21,12 +21,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TicTacToeService_StartGame_FullMethodName          = "/tictactoe.TicTacToeService/StartGame"
-	TicTacToeService_SearchPendingGames_FullMethodName = "/tictactoe.TicTacToeService/SearchPendingGames"
-	TicTacToeService_JoinGame_FullMethodName           = "/tictactoe.TicTacToeService/JoinGame"
-	TicTacToeService_MakeMove_FullMethodName           = "/tictactoe.TicTacToeService/MakeMove"
-	TicTacToeService_GetGame_FullMethodName            = "/tictactoe.TicTacToeService/GetGame"
-	TicTacToeService_GetUserStats_FullMethodName       = "/tictactoe.TicTacToeService/GetUserStats"
+	TicTacToeService_StartGame_FullMethodName            = "/tictactoe.TicTacToeService/StartGame"
+	TicTacToeService_SearchPendingGames_FullMethodName   = "/tictactoe.TicTacToeService/SearchPendingGames"
+	TicTacToeService_JoinGame_FullMethodName             = "/tictactoe.TicTacToeService/JoinGame"
+	TicTacToeService_MakeMove_FullMethodName             = "/tictactoe.TicTacToeService/MakeMove"
+	TicTacToeService_GetGame_FullMethodName              = "/tictactoe.TicTacToeService/GetGame"
+	TicTacToeService_GetUserStats_FullMethodName         = "/tictactoe.TicTacToeService/GetUserStats"
+	TicTacToeService_ListUserGames_FullMethodName        = "/tictactoe.TicTacToeService/ListUserGames"
+	TicTacToeService_SpectateGame_FullMethodName         = "/tictactoe.TicTacToeService/SpectateGame"
+	TicTacToeService_SetSpectatorsAllowed_FullMethodName = "/tictactoe.TicTacToeService/SetSpectatorsAllowed"
+	TicTacToeService_ListLiveGames_FullMethodName        = "/tictactoe.TicTacToeService/ListLiveGames"
+	TicTacToeService_OfferRematch_FullMethodName         = "/tictactoe.TicTacToeService/OfferRematch"
+	TicTacToeService_AcceptRematch_FullMethodName        = "/tictactoe.TicTacToeService/AcceptRematch"
+	TicTacToeService_DeclineRematch_FullMethodName       = "/tictactoe.TicTacToeService/DeclineRematch"
+	TicTacToeService_OfferDraw_FullMethodName            = "/tictactoe.TicTacToeService/OfferDraw"
+	TicTacToeService_AcceptDraw_FullMethodName           = "/tictactoe.TicTacToeService/AcceptDraw"
+	TicTacToeService_DeclineDraw_FullMethodName          = "/tictactoe.TicTacToeService/DeclineDraw"
+	TicTacToeService_RequestTakeback_FullMethodName      = "/tictactoe.TicTacToeService/RequestTakeback"
+	TicTacToeService_RespondTakeback_FullMethodName      = "/tictactoe.TicTacToeService/RespondTakeback"
 )
 
 // TicTacToeServiceClient is the client API for TicTacToeService service.
@@ -39,6 +51,34 @@ type TicTacToeServiceClient interface {
 	MakeMove(ctx context.Context, in *MakeMoveRequest, opts ...grpc.CallOption) (*MakeMoveResponse, error)
 	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*GetGameResponse, error)
 	GetUserStats(ctx context.Context, in *GetUserStatsRequest, opts ...grpc.CallOption) (*GetUserStatsResponse, error)
+	ListUserGames(ctx context.Context, in *ListUserGamesRequest, opts ...grpc.CallOption) (*ListUserGamesResponse, error)
+	// SpectateGame streams the game's state, starting with a snapshot and then
+	// after every change, until the game finishes. Players' streams stay open
+	// after the finish until any rematch offer is accepted, declined or
+	// expires.
+	SpectateGame(ctx context.Context, in *SpectateGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SpectateGameResponse], error)
+	SetSpectatorsAllowed(ctx context.Context, in *SetSpectatorsAllowedRequest, opts ...grpc.CallOption) (*SetSpectatorsAllowedResponse, error)
+	ListLiveGames(ctx context.Context, in *ListLiveGamesRequest, opts ...grpc.CallOption) (*ListLiveGamesResponse, error)
+	// OfferRematch offers the opponent a rematch of a finished game; the offer
+	// lapses if not answered in time.
+	OfferRematch(ctx context.Context, in *OfferRematchRequest, opts ...grpc.CallOption) (*OfferRematchResponse, error)
+	// AcceptRematch starts a new game between the same players with colors
+	// swapped, unless handicap stones were placed: those stay with the same
+	// player, so the seats do too.
+	AcceptRematch(ctx context.Context, in *AcceptRematchRequest, opts ...grpc.CallOption) (*AcceptRematchResponse, error)
+	DeclineRematch(ctx context.Context, in *DeclineRematchRequest, opts ...grpc.CallOption) (*DeclineRematchResponse, error)
+	// OfferDraw offers the opponent a draw; moving instead of answering
+	// declines it.
+	OfferDraw(ctx context.Context, in *OfferDrawRequest, opts ...grpc.CallOption) (*OfferDrawResponse, error)
+	AcceptDraw(ctx context.Context, in *AcceptDrawRequest, opts ...grpc.CallOption) (*AcceptDrawResponse, error)
+	DeclineDraw(ctx context.Context, in *DeclineDrawRequest, opts ...grpc.CallOption) (*DeclineDrawResponse, error)
+	// RequestTakeback asks the opponent to let the caller retract their last
+	// move. Only unranked games allow takebacks.
+	RequestTakeback(ctx context.Context, in *RequestTakebackRequest, opts ...grpc.CallOption) (*RequestTakebackResponse, error)
+	// RespondTakeback answers the opponent's request. Accepting removes the
+	// requester's last move, and the reply to it if any, and gives the turn
+	// back to the requester.
+	RespondTakeback(ctx context.Context, in *RespondTakebackRequest, opts ...grpc.CallOption) (*RespondTakebackResponse, error)
 }
 
 type ticTacToeServiceClient struct {
@@ -109,6 +149,135 @@ func (c *ticTacToeServiceClient) GetUserStats(ctx context.Context, in *GetUserSt
 	return out, nil
 }
 
+func (c *ticTacToeServiceClient) ListUserGames(ctx context.Context, in *ListUserGamesRequest, opts ...grpc.CallOption) (*ListUserGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserGamesResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_ListUserGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeServiceClient) SpectateGame(ctx context.Context, in *SpectateGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SpectateGameResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TicTacToeService_ServiceDesc.Streams[0], TicTacToeService_SpectateGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SpectateGameRequest, SpectateGameResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TicTacToeService_SpectateGameClient = grpc.ServerStreamingClient[SpectateGameResponse]
+
+func (c *ticTacToeServiceClient) SetSpectatorsAllowed(ctx context.Context, in *SetSpectatorsAllowedRequest, opts ...grpc.CallOption) (*SetSpectatorsAllowedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSpectatorsAllowedResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_SetSpectatorsAllowed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeServiceClient) ListLiveGames(ctx context.Context, in *ListLiveGamesRequest, opts ...grpc.CallOption) (*ListLiveGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLiveGamesResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_ListLiveGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeServiceClient) OfferRematch(ctx context.Context, in *OfferRematchRequest, opts ...grpc.CallOption) (*OfferRematchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OfferRematchResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_OfferRematch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeServiceClient) AcceptRematch(ctx context.Context, in *AcceptRematchRequest, opts ...grpc.CallOption) (*AcceptRematchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptRematchResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_AcceptRematch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeServiceClient) DeclineRematch(ctx context.Context, in *DeclineRematchRequest, opts ...grpc.CallOption) (*DeclineRematchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineRematchResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_DeclineRematch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeServiceClient) OfferDraw(ctx context.Context, in *OfferDrawRequest, opts ...grpc.CallOption) (*OfferDrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OfferDrawResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_OfferDraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeServiceClient) AcceptDraw(ctx context.Context, in *AcceptDrawRequest, opts ...grpc.CallOption) (*AcceptDrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptDrawResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_AcceptDraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeServiceClient) DeclineDraw(ctx context.Context, in *DeclineDrawRequest, opts ...grpc.CallOption) (*DeclineDrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineDrawResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_DeclineDraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeServiceClient) RequestTakeback(ctx context.Context, in *RequestTakebackRequest, opts ...grpc.CallOption) (*RequestTakebackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestTakebackResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_RequestTakeback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeServiceClient) RespondTakeback(ctx context.Context, in *RespondTakebackRequest, opts ...grpc.CallOption) (*RespondTakebackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondTakebackResponse)
+	err := c.cc.Invoke(ctx, TicTacToeService_RespondTakeback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TicTacToeServiceServer is the server API for TicTacToeService service.
 // All implementations must embed UnimplementedTicTacToeServiceServer
 // for forward compatibility.
@@ -119,6 +288,34 @@ type TicTacToeServiceServer interface {
 	MakeMove(context.Context, *MakeMoveRequest) (*MakeMoveResponse, error)
 	GetGame(context.Context, *GetGameRequest) (*GetGameResponse, error)
 	GetUserStats(context.Context, *GetUserStatsRequest) (*GetUserStatsResponse, error)
+	ListUserGames(context.Context, *ListUserGamesRequest) (*ListUserGamesResponse, error)
+	// SpectateGame streams the game's state, starting with a snapshot and then
+	// after every change, until the game finishes. Players' streams stay open
+	// after the finish until any rematch offer is accepted, declined or
+	// expires.
+	SpectateGame(*SpectateGameRequest, grpc.ServerStreamingServer[SpectateGameResponse]) error
+	SetSpectatorsAllowed(context.Context, *SetSpectatorsAllowedRequest) (*SetSpectatorsAllowedResponse, error)
+	ListLiveGames(context.Context, *ListLiveGamesRequest) (*ListLiveGamesResponse, error)
+	// OfferRematch offers the opponent a rematch of a finished game; the offer
+	// lapses if not answered in time.
+	OfferRematch(context.Context, *OfferRematchRequest) (*OfferRematchResponse, error)
+	// AcceptRematch starts a new game between the same players with colors
+	// swapped, unless handicap stones were placed: those stay with the same
+	// player, so the seats do too.
+	AcceptRematch(context.Context, *AcceptRematchRequest) (*AcceptRematchResponse, error)
+	DeclineRematch(context.Context, *DeclineRematchRequest) (*DeclineRematchResponse, error)
+	// OfferDraw offers the opponent a draw; moving instead of answering
+	// declines it.
+	OfferDraw(context.Context, *OfferDrawRequest) (*OfferDrawResponse, error)
+	AcceptDraw(context.Context, *AcceptDrawRequest) (*AcceptDrawResponse, error)
+	DeclineDraw(context.Context, *DeclineDrawRequest) (*DeclineDrawResponse, error)
+	// RequestTakeback asks the opponent to let the caller retract their last
+	// move. Only unranked games allow takebacks.
+	RequestTakeback(context.Context, *RequestTakebackRequest) (*RequestTakebackResponse, error)
+	// RespondTakeback answers the opponent's request. Accepting removes the
+	// requester's last move, and the reply to it if any, and gives the turn
+	// back to the requester.
+	RespondTakeback(context.Context, *RespondTakebackRequest) (*RespondTakebackResponse, error)
 	mustEmbedUnimplementedTicTacToeServiceServer()
 }
 
@@ -147,6 +344,42 @@ func (UnimplementedTicTacToeServiceServer) GetGame(context.Context, *GetGameRequ
 func (UnimplementedTicTacToeServiceServer) GetUserStats(context.Context, *GetUserStatsRequest) (*GetUserStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserStats not implemented")
 }
+func (UnimplementedTicTacToeServiceServer) ListUserGames(context.Context, *ListUserGamesRequest) (*ListUserGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserGames not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) SpectateGame(*SpectateGameRequest, grpc.ServerStreamingServer[SpectateGameResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SpectateGame not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) SetSpectatorsAllowed(context.Context, *SetSpectatorsAllowedRequest) (*SetSpectatorsAllowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpectatorsAllowed not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) ListLiveGames(context.Context, *ListLiveGamesRequest) (*ListLiveGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLiveGames not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) OfferRematch(context.Context, *OfferRematchRequest) (*OfferRematchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferRematch not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) AcceptRematch(context.Context, *AcceptRematchRequest) (*AcceptRematchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptRematch not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) DeclineRematch(context.Context, *DeclineRematchRequest) (*DeclineRematchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineRematch not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) OfferDraw(context.Context, *OfferDrawRequest) (*OfferDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferDraw not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) AcceptDraw(context.Context, *AcceptDrawRequest) (*AcceptDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDraw not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) DeclineDraw(context.Context, *DeclineDrawRequest) (*DeclineDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineDraw not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) RequestTakeback(context.Context, *RequestTakebackRequest) (*RequestTakebackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestTakeback not implemented")
+}
+func (UnimplementedTicTacToeServiceServer) RespondTakeback(context.Context, *RespondTakebackRequest) (*RespondTakebackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondTakeback not implemented")
+}
 func (UnimplementedTicTacToeServiceServer) mustEmbedUnimplementedTicTacToeServiceServer() {}
 func (UnimplementedTicTacToeServiceServer) testEmbeddedByValue()                          {}
 
@@ -276,6 +509,215 @@ func _TicTacToeService_GetUserStats_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TicTacToeService_ListUserGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).ListUserGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_ListUserGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).ListUserGames(ctx, req.(*ListUserGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeService_SpectateGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpectateGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TicTacToeServiceServer).SpectateGame(m, &grpc.GenericServerStream[SpectateGameRequest, SpectateGameResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TicTacToeService_SpectateGameServer = grpc.ServerStreamingServer[SpectateGameResponse]
+
+func _TicTacToeService_SetSpectatorsAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSpectatorsAllowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).SetSpectatorsAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_SetSpectatorsAllowed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).SetSpectatorsAllowed(ctx, req.(*SetSpectatorsAllowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeService_ListLiveGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLiveGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).ListLiveGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_ListLiveGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).ListLiveGames(ctx, req.(*ListLiveGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeService_OfferRematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OfferRematchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).OfferRematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_OfferRematch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).OfferRematch(ctx, req.(*OfferRematchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeService_AcceptRematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptRematchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).AcceptRematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_AcceptRematch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).AcceptRematch(ctx, req.(*AcceptRematchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeService_DeclineRematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineRematchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).DeclineRematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_DeclineRematch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).DeclineRematch(ctx, req.(*DeclineRematchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeService_OfferDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OfferDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).OfferDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_OfferDraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).OfferDraw(ctx, req.(*OfferDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeService_AcceptDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).AcceptDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_AcceptDraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).AcceptDraw(ctx, req.(*AcceptDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeService_DeclineDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).DeclineDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_DeclineDraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).DeclineDraw(ctx, req.(*DeclineDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeService_RequestTakeback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestTakebackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).RequestTakeback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_RequestTakeback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).RequestTakeback(ctx, req.(*RequestTakebackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeService_RespondTakeback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondTakebackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServiceServer).RespondTakeback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToeService_RespondTakeback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServiceServer).RespondTakeback(ctx, req.(*RespondTakebackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TicTacToeService_ServiceDesc is the grpc.ServiceDesc for TicTacToeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -307,7 +749,57 @@ var TicTacToeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserStats",
 			Handler:    _TicTacToeService_GetUserStats_Handler,
 		},
+		{
+			MethodName: "ListUserGames",
+			Handler:    _TicTacToeService_ListUserGames_Handler,
+		},
+		{
+			MethodName: "SetSpectatorsAllowed",
+			Handler:    _TicTacToeService_SetSpectatorsAllowed_Handler,
+		},
+		{
+			MethodName: "ListLiveGames",
+			Handler:    _TicTacToeService_ListLiveGames_Handler,
+		},
+		{
+			MethodName: "OfferRematch",
+			Handler:    _TicTacToeService_OfferRematch_Handler,
+		},
+		{
+			MethodName: "AcceptRematch",
+			Handler:    _TicTacToeService_AcceptRematch_Handler,
+		},
+		{
+			MethodName: "DeclineRematch",
+			Handler:    _TicTacToeService_DeclineRematch_Handler,
+		},
+		{
+			MethodName: "OfferDraw",
+			Handler:    _TicTacToeService_OfferDraw_Handler,
+		},
+		{
+			MethodName: "AcceptDraw",
+			Handler:    _TicTacToeService_AcceptDraw_Handler,
+		},
+		{
+			MethodName: "DeclineDraw",
+			Handler:    _TicTacToeService_DeclineDraw_Handler,
+		},
+		{
+			MethodName: "RequestTakeback",
+			Handler:    _TicTacToeService_RequestTakeback_Handler,
+		},
+		{
+			MethodName: "RespondTakeback",
+			Handler:    _TicTacToeService_RespondTakeback_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SpectateGame",
+			Handler:       _TicTacToeService_SpectateGame_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/tictactoe.proto",
 }
@@ -21,9 +21,28 @@ if ! command -v protoc-gen-go-grpc &> /dev/null; then
     go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@latest
 fi
 
+if ! command -v protoc-gen-grpc-gateway &> /dev/null; then
+    echo "Installing protoc-gen-grpc-gateway..."
+    go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway@latest
+fi
+
+if ! command -v protoc-gen-openapiv2 &> /dev/null; then
+    echo "Installing protoc-gen-openapiv2..."
+    go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2@latest
+fi
+
+if ! command -v protoc-gen-connect-go &> /dev/null; then
+    echo "Installing protoc-gen-connect-go..."
+    go install connectrpc.com/connect/cmd/protoc-gen-connect-go@latest
+fi
+
 # Generate the code
-protoc --go_out=. --go_opt=paths=source_relative \
+protoc -I . -I third_party/googleapis \
+    --go_out=. --go_opt=paths=source_relative \
     --go-grpc_out=. --go-grpc_opt=paths=source_relative \
+    --grpc-gateway_out=. --grpc-gateway_opt=paths=source_relative \
+    --openapiv2_out=. --openapiv2_opt=json_names_for_fields=false \
+    --connect-go_out=. --connect-go_opt=paths=source_relative \
     proto/*.proto
 
 echo "Protobuf code generated successfully!"
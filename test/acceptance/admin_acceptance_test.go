@@ -0,0 +1,146 @@
+// test/acceptance/admin_acceptance_test.go
+package integration
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"tictactoe/internal/adapters/grpc/handler"
+	"tictactoe/internal/adapters/grpc/interceptor"
+	"tictactoe/internal/adapters/repository"
+	"tictactoe/internal/application/service"
+	"tictactoe/internal/domain/config"
+	pb "tictactoe/proto"
+)
+
+const testAdminToken = "s3cret"
+
+func setupAdminServer(t *testing.T) (pb.TicTacToeServiceClient, pb.AdminServiceClient) {
+	t.Helper()
+
+	gameRepo := repository.NewInMemoryGameRepository()
+	userRepo := repository.NewInMemoryUserRepository()
+	gameService := service.NewGameService(gameRepo, userRepo, config.DefaultConfig())
+	adminService := service.NewAdminService(gameRepo, userRepo)
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		interceptor.UnaryBearerAuth(testAdminToken, pb.AdminService_ServiceDesc.ServiceName),
+	))
+	pb.RegisterTicTacToeServiceServer(server, handler.NewGRPCHandler(gameService))
+	pb.RegisterAdminServiceServer(server, handler.NewAdminHandler(adminService))
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewTicTacToeServiceClient(conn), pb.NewAdminServiceClient(conn)
+}
+
+func adminContext(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func TestAdminAuthentication(t *testing.T) {
+	players, admin := setupAdminServer(t)
+
+	_, err := admin.Stats(context.Background(), &pb.StatsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = admin.Stats(adminContext("wrong"), &pb.StatsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = admin.Stats(adminContext(testAdminToken), &pb.StatsRequest{})
+	assert.NoError(t, err)
+
+	// The player-facing service does not require the credential.
+	_, err = players.GetUserStats(context.Background(), &pb.GetUserStatsRequest{UserId: "player1"})
+	assert.NoError(t, err)
+}
+
+func TestAdminOperations(t *testing.T) {
+	players, admin := setupAdminServer(t)
+	ctx := adminContext(testAdminToken)
+
+	start, err := players.StartGame(context.Background(), &pb.StartGameRequest{UserId: "player1", BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+	_, err = players.JoinGame(context.Background(), &pb.JoinGameRequest{UserId: "player2", GameId: start.GameId})
+	require.NoError(t, err)
+	pending, err := players.StartGame(context.Background(), &pb.StartGameRequest{UserId: "player3", BoardSize: 4, WinningLength: 4})
+	require.NoError(t, err)
+
+	// List and filter
+	list, err := admin.ListGames(ctx, &pb.ListGamesRequest{Status: pb.GameStatus_IN_PROGRESS.Enum()})
+	require.NoError(t, err)
+	require.Len(t, list.Games, 1)
+	assert.Equal(t, start.GameId, list.Games[0].Id)
+
+	list, err = admin.ListGames(ctx, &pb.ListGamesRequest{PlayerId: "player3"})
+	require.NoError(t, err)
+	require.Len(t, list.Games, 1)
+	assert.Equal(t, pending.GameId, list.Games[0].Id)
+
+	list, err = admin.ListGames(ctx, &pb.ListGamesRequest{MinAgeSeconds: 3600})
+	require.NoError(t, err)
+	assert.Empty(t, list.Games)
+
+	// Force-end with a winner updates stats
+	_, err = admin.ForceEndGame(ctx, &pb.ForceEndGameRequest{GameId: start.GameId, WinnerId: "player1", Draw: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ended, err := admin.ForceEndGame(ctx, &pb.ForceEndGameRequest{GameId: start.GameId, WinnerId: "player1"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.GameStatus_FINISHED_WIN, ended.Game.Status)
+
+	_, err = admin.ForceEndGame(ctx, &pb.ForceEndGameRequest{GameId: start.GameId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	stats, err := players.GetUserStats(context.Background(), &pb.GetUserStatsRequest{UserId: "player1"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), stats.Stats.Wins)
+
+	// Adjust and reset stats
+	adjusted, err := admin.AdjustUserStats(ctx, &pb.AdjustUserStatsRequest{UserId: "player1", WinsDelta: -1, DrawsDelta: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), adjusted.Stats.Wins)
+	assert.Equal(t, int32(2), adjusted.Stats.TotalGames)
+
+	_, err = admin.AdjustUserStats(ctx, &pb.AdjustUserStatsRequest{UserId: "player1", LossesDelta: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	reset, err := admin.ResetUserStats(ctx, &pb.ResetUserStatsRequest{UserId: "player1"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), reset.Stats.TotalGames)
+
+	_, err = admin.ResetUserStats(ctx, &pb.ResetUserStatsRequest{UserId: "nobody"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Server stats
+	serverStats, err := admin.Stats(ctx, &pb.StatsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), serverStats.TotalGames)
+	assert.Equal(t, int64(1), serverStats.GamesByStatus["PENDING"])
+	assert.Equal(t, int64(1), serverStats.GamesByStatus["FINISHED_WIN"])
+
+	// Delete
+	_, err = admin.DeleteGame(ctx, &pb.DeleteGameRequest{GameId: pending.GameId})
+	require.NoError(t, err)
+	_, err = players.GetGame(context.Background(), &pb.GetGameRequest{GameId: pending.GameId, UserId: "player3"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = admin.DeleteGame(ctx, &pb.DeleteGameRequest{GameId: pending.GameId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
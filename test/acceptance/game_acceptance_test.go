@@ -4,11 +4,15 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"tictactoe/internal/adapters/grpc/handler"
+	"tictactoe/internal/adapters/pubsub"
 	"tictactoe/internal/adapters/repository"
 	"tictactoe/internal/application/service"
 	"tictactoe/internal/domain/config"
@@ -19,8 +23,12 @@ func setupTestServer() *handler.GRPCHandler {
 	gameRepo := repository.NewInMemoryGameRepository()
 	userRepo := repository.NewInMemoryUserRepository()
 	cfg := config.DefaultConfig()
-	gameService := service.NewGameService(gameRepo, userRepo, cfg)
-	return handler.NewGRPCHandler(gameService)
+	broker := pubsub.NewBroker()
+	gameService := service.NewGameService(gameRepo, userRepo, cfg,
+		service.WithEventPublisher(broker),
+		service.WithSpectatorTracker(broker),
+	)
+	return handler.NewGRPCHandler(gameService, handler.WithBroker(broker))
 }
 
 func TestCompleteGameFlow(t *testing.T) {
@@ -121,7 +129,8 @@ func TestDrawGame(t *testing.T) {
 		GameId: gameID,
 	})
 
-	// Play to a draw
+	// Play to a draw; the last empty cell can complete no line, so the game
+	// ends without it being filled
 	moves := []struct {
 		player string
 		row    int32
@@ -135,7 +144,6 @@ func TestDrawGame(t *testing.T) {
 		{"player2", 1, 2}, // O
 		{"player1", 2, 1}, // X
 		{"player2", 2, 0}, // O
-		{"player1", 2, 2}, // X
 	}
 
 	var lastResp *pb.MakeMoveResponse
@@ -161,27 +169,319 @@ func TestDrawGame(t *testing.T) {
 	assert.Equal(t, int32(1), stats2.Stats.Draws)
 }
 
+func TestDrawOffer(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	start, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardSize: 10, WinningLength: 5})
+	require.NoError(t, err)
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: start.GameId})
+	require.NoError(t, err)
+
+	offer, err := server.OfferDraw(ctx, &pb.OfferDrawRequest{GameId: start.GameId, UserId: "player2"})
+	require.NoError(t, err)
+	assert.Equal(t, "player2", offer.Game.DrawOfferedBy)
+
+	_, err = server.OfferDraw(ctx, &pb.OfferDrawRequest{GameId: start.GameId, UserId: "player1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = server.AcceptDraw(ctx, &pb.AcceptDrawRequest{GameId: start.GameId, UserId: "player3"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	accept, err := server.AcceptDraw(ctx, &pb.AcceptDrawRequest{GameId: start.GameId, UserId: "player1"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.GameStatus_FINISHED_DRAW, accept.Game.Status)
+	assert.Empty(t, accept.Game.DrawOfferedBy)
+
+	_, err = server.DeclineDraw(ctx, &pb.DeclineDrawRequest{GameId: start.GameId, UserId: "player2"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	stats, err := server.GetUserStats(ctx, &pb.GetUserStatsRequest{UserId: "player2"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), stats.Stats.Draws)
+}
+
+func TestTakebacks(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	// Public games are ranked and do not allow takebacks
+	ranked, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1"})
+	require.NoError(t, err)
+	join, err := server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: ranked.GameId})
+	require.NoError(t, err)
+	assert.True(t, join.Game.Ranked)
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player1", GameId: ranked.GameId, Row: 0, Col: 0})
+	require.NoError(t, err)
+	_, err = server.RequestTakeback(ctx, &pb.RequestTakebackRequest{UserId: "player1", GameId: ranked.GameId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	casual, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", InvitedUserId: "player2"})
+	require.NoError(t, err)
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: casual.GameId})
+	require.NoError(t, err)
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player1", GameId: casual.GameId, Row: 0, Col: 0})
+	require.NoError(t, err)
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player2", GameId: casual.GameId, Row: 1, Col: 1})
+	require.NoError(t, err)
+
+	request, err := server.RequestTakeback(ctx, &pb.RequestTakebackRequest{UserId: "player1", GameId: casual.GameId})
+	require.NoError(t, err)
+	assert.Equal(t, "player1", request.Game.TakebackRequestedBy)
+
+	_, err = server.RespondTakeback(ctx, &pb.RespondTakebackRequest{UserId: "player1", GameId: casual.GameId, Accept: true})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The opponent already replied, so both plies are retracted
+	resp, err := server.RespondTakeback(ctx, &pb.RespondTakebackRequest{UserId: "player2", GameId: casual.GameId, Accept: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "", "", "", "", "", "", "", ""}, resp.Game.Board)
+	assert.Equal(t, "player1", resp.Game.CurrentPlayerId)
+	assert.Empty(t, resp.Game.Moves)
+	require.Len(t, resp.Game.Takebacks, 1)
+	assert.Equal(t, "player1", resp.Game.Takebacks[0].RequestedBy)
+	assert.Len(t, resp.Game.Takebacks[0].Moves, 2)
+}
+
+func TestGameVariant(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	_, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", Variant: "nope"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	start, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1"})
+	require.NoError(t, err)
+
+	search, err := server.SearchPendingGames(ctx, &pb.SearchPendingGamesRequest{Variant: "standard"})
+	require.NoError(t, err)
+	require.Len(t, search.Games, 1)
+	assert.Equal(t, "standard", search.Games[0].Variant)
+
+	join, err := server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: start.GameId})
+	require.NoError(t, err)
+	assert.Equal(t, "standard", join.Game.Variant)
+}
+
+func TestRenjuForbiddenMove(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	start, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardSize: 7, WinningLength: 5, Variant: "renju"})
+	require.NoError(t, err)
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: start.GameId})
+	require.NoError(t, err)
+
+	moves := []struct {
+		userID   string
+		row, col int32
+	}{
+		{"player1", 3, 1}, {"player2", 0, 0},
+		{"player1", 3, 2}, {"player2", 0, 6},
+		{"player1", 1, 3}, {"player2", 6, 0},
+		{"player1", 2, 3}, {"player2", 6, 6},
+	}
+	for _, move := range moves {
+		_, err := server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: move.userID, GameId: start.GameId, Row: move.row, Col: move.col})
+		require.NoError(t, err)
+	}
+
+	// Two open threes at once are forbidden for the first player
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player1", GameId: start.GameId, Row: 3, Col: 3})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "double three")
+
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player1", GameId: start.GameId, Row: 5, Col: 5})
+	require.NoError(t, err)
+}
+
+func TestGravityGame(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	start, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardWidth: 7, BoardHeight: 6, WinningLength: 4, Variant: "gravity"})
+	require.NoError(t, err)
+
+	// Boards of other dimensions are matched separately
+	other, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player2", BoardWidth: 6, BoardHeight: 7, WinningLength: 4, Variant: "gravity"})
+	require.NoError(t, err)
+	assert.NotEqual(t, start.GameId, other.GameId)
+
+	join, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player3", BoardWidth: 7, BoardHeight: 6, WinningLength: 4, Variant: "gravity"})
+	require.NoError(t, err)
+	require.Equal(t, start.GameId, join.GameId)
+
+	game, err := server.GetGame(ctx, &pb.GetGameRequest{GameId: start.GameId, UserId: "player1"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), game.Game.BoardWidth)
+	assert.Equal(t, int32(6), game.Game.BoardHeight)
+	assert.Zero(t, game.Game.BoardSize)
+	assert.Len(t, game.Game.Board, 42)
+
+	// A move only names the column
+	for i := 0; i < 6; i++ {
+		userID := "player1"
+		if i%2 == 1 {
+			userID = "player3"
+		}
+		_, err := server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: userID, GameId: start.GameId, Col: 2})
+		require.NoError(t, err)
+	}
+	game, err = server.GetGame(ctx, &pb.GetGameRequest{GameId: start.GameId, UserId: "player1"})
+	require.NoError(t, err)
+	assert.Equal(t, "X", game.Game.Board[5*7+2])
+	assert.Equal(t, "O", game.Game.Board[0*7+2])
+
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player1", GameId: start.GameId, Col: 2})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "column is full")
+}
+
+func TestUltimateGame(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	// Ultimate games are always 9x9, whatever size is asked for
+	start, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", Variant: "ultimate"})
+	require.NoError(t, err)
+	join, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player2", BoardSize: 5, Variant: "ultimate"})
+	require.NoError(t, err)
+	require.Equal(t, start.GameId, join.GameId)
+
+	game, err := server.GetGame(ctx, &pb.GetGameRequest{GameId: start.GameId, UserId: "player1"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(9), game.Game.BoardSize)
+	require.NotNil(t, game.Game.Ultimate)
+	assert.Equal(t, make([]string, 9), game.Game.Ultimate.MetaBoard)
+	assert.Nil(t, game.Game.Ultimate.ForcedBoard)
+
+	move, err := server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player1", GameId: start.GameId, Row: 4, Col: 3})
+	require.NoError(t, err)
+	require.NotNil(t, move.Game.Ultimate.ForcedBoard)
+	assert.Equal(t, int32(3), move.Game.Ultimate.GetForcedBoard())
+
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player2", GameId: start.GameId, Row: 0, Col: 0})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "forced local board")
+
+	standard, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player3"})
+	require.NoError(t, err)
+	game, err = server.GetGame(ctx, &pb.GetGameRequest{GameId: standard.GameId, UserId: "player3"})
+	require.NoError(t, err)
+	assert.Nil(t, game.Game.Ultimate)
+}
+
+func TestObstaclesAndHandicap(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	_, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardSize: 3, Obstacles: []*pb.Position{{Row: 0, Col: 5}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	start, err := server.StartGame(ctx, &pb.StartGameRequest{
+		UserId:         "player1",
+		BoardSize:      5,
+		WinningLength:  4,
+		Obstacles:      []*pb.Position{{Row: 0, Col: 1}},
+		HandicapStones: []*pb.Position{{Row: 2, Col: 2}},
+		InvitedUserId:  "player2",
+	})
+	require.NoError(t, err)
+	join, err := server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: start.GameId})
+	require.NoError(t, err)
+
+	// Blocked cells are told apart from empty ones on the board
+	assert.Equal(t, "#", join.Game.Board[0*5+1])
+	assert.Equal(t, "", join.Game.Board[0*5+0])
+	assert.Equal(t, "O", join.Game.Board[2*5+2])
+	require.Len(t, join.Game.HandicapStones, 1)
+	assert.Equal(t, int32(2), join.Game.HandicapStones[0].Row)
+
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player1", GameId: start.GameId, Row: 0, Col: 1})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "cell is blocked")
+}
+
+func TestMultiPlayerGame(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	_, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardSize: 4, WinningLength: 3, Seats: 5})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	start, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardSize: 4, WinningLength: 3, Seats: 3})
+	require.NoError(t, err)
+	_, err = server.StartGame(ctx, &pb.StartGameRequest{UserId: "player2", BoardSize: 4, WinningLength: 3, Seats: 3})
+	require.NoError(t, err)
+
+	search, err := server.SearchPendingGames(ctx, &pb.SearchPendingGamesRequest{Seats: 3})
+	require.NoError(t, err)
+	require.Len(t, search.Games, 1)
+	assert.Equal(t, int32(3), search.Games[0].Seats)
+	assert.Equal(t, int32(1), search.Games[0].OpenSeats)
+
+	join, err := server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player3", GameId: start.GameId})
+	require.NoError(t, err)
+	assert.Equal(t, pb.GameStatus_IN_PROGRESS, join.Status)
+	assert.Equal(t, []string{"player1", "player2", "player3"}, join.Game.PlayerIds)
+	assert.Equal(t, int32(3), join.Game.Seats)
+
+	_, err = server.OfferDraw(ctx, &pb.OfferDrawRequest{UserId: "player1", GameId: start.GameId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Turns go round the table; X wins the top row
+	moves := [][2]int32{{0, 0}, {1, 0}, {2, 0}, {0, 1}, {1, 1}, {2, 1}, {0, 2}}
+	for i, move := range moves {
+		_, err := server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: join.Game.PlayerIds[i%3], GameId: start.GameId, Row: move[0], Col: move[1]})
+		require.NoError(t, err)
+	}
+	game, err := server.GetGame(ctx, &pb.GetGameRequest{GameId: start.GameId, UserId: "player3"})
+	require.NoError(t, err)
+	assert.Equal(t, "Y", game.Game.Board[2*4+1])
+	assert.Equal(t, "player1", game.Game.WinnerId)
+
+	for userID, losses := range map[string]int32{"player1": 0, "player2": 1, "player3": 1} {
+		stats, err := server.GetUserStats(ctx, &pb.GetUserStatsRequest{UserId: userID})
+		require.NoError(t, err)
+		assert.Equal(t, losses, stats.Stats.Losses, userID)
+	}
+}
+
 func TestErrorConditions(t *testing.T) {
 	server := setupTestServer()
 	ctx := context.Background()
 
 	// Test invalid move on non-existent game
-	moveResp, err := server.MakeMove(ctx, &pb.MakeMoveRequest{
+	_, err := server.MakeMove(ctx, &pb.MakeMoveRequest{
 		UserId: "player1",
 		GameId: "nonexistent",
 		Row:    0,
 		Col:    0,
 	})
-	require.NoError(t, err) // gRPC doesn't return error for business logic errors
-	assert.Contains(t, moveResp.Message, "game not found")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "game not found")
 
 	// Test joining non-existent game
-	joinResp, err := server.JoinGame(ctx, &pb.JoinGameRequest{
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{
 		UserId: "player1",
 		GameId: "nonexistent",
 	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "game not found")
+
+	// Business rule violations are reported with precise status codes
+	startResp, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1"})
+	require.NoError(t, err)
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: startResp.GameId})
 	require.NoError(t, err)
-	assert.Contains(t, joinResp.Message, "game not found")
+
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player2", GameId: startResp.GameId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player1", GameId: startResp.GameId, Row: 7})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: "player3", GameId: startResp.GameId})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
 }
 
 func TestConcurrentAccess(t *testing.T) {
@@ -270,4 +570,200 @@ func TestCustomBoardSize(t *testing.T) {
 	assert.Equal(t, int32(5), gameResp.Game.BoardSize)
 	assert.Equal(t, int32(4), gameResp.Game.WinningLength)
 	assert.Len(t, gameResp.Game.Board, 25) // 5x5 = 25 cells
+
+	// Clients that only send board_size get a square board
+	assert.Equal(t, int32(5), gameResp.Game.BoardWidth)
+	assert.Equal(t, int32(5), gameResp.Game.BoardHeight)
+}
+
+func TestRectangularBoard(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	start, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardWidth: 5, BoardHeight: 3, WinningLength: 3})
+	require.NoError(t, err)
+
+	search, err := server.SearchPendingGames(ctx, &pb.SearchPendingGamesRequest{BoardWidth: 5, BoardHeight: 3})
+	require.NoError(t, err)
+	require.Len(t, search.Games, 1)
+	assert.Equal(t, int32(5), search.Games[0].BoardWidth)
+	assert.Zero(t, search.Games[0].BoardSize)
+
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: start.GameId})
+	require.NoError(t, err)
+	moves := []struct {
+		userID   string
+		row, col int32
+	}{
+		{"player1", 2, 2}, {"player2", 0, 0},
+		{"player1", 2, 3}, {"player2", 0, 1},
+		{"player1", 2, 4},
+	}
+	var resp *pb.MakeMoveResponse
+	for _, move := range moves {
+		resp, err = server.MakeMove(ctx, &pb.MakeMoveRequest{UserId: move.userID, GameId: start.GameId, Row: move.row, Col: move.col})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, pb.GameStatus_FINISHED_WIN, resp.Status)
+}
+
+func TestContextCancellation(t *testing.T) {
+	server := setupTestServer()
+
+	// Cancelled callers get a Canceled status instead of a business error
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1"})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	_, err = server.GetUserStats(ctx, &pb.GetUserStatsRequest{UserId: "player1"})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	// Expired deadlines are reported as DeadlineExceeded
+	deadlineCtx, deadlineCancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer deadlineCancel()
+
+	_, err = server.SearchPendingGames(deadlineCtx, &pb.SearchPendingGamesRequest{})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// No game was created by the cancelled call
+	searchResp, err := server.SearchPendingGames(context.Background(), &pb.SearchPendingGamesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, searchResp.Games)
+}
+
+func TestSearchPendingGames(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	first, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardSize: 3})
+	require.NoError(t, err)
+	second, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player2", BoardSize: 4})
+	require.NoError(t, err)
+	third, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player3", BoardSize: 5})
+	require.NoError(t, err)
+
+	resp, err := server.SearchPendingGames(ctx, &pb.SearchPendingGamesRequest{OrderBy: pb.PendingGameOrder_ORDER_NEWEST_FIRST, PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Games, 2)
+	assert.Equal(t, third.GameId, resp.Games[0].GameId)
+	assert.Equal(t, second.GameId, resp.Games[1].GameId)
+	assert.Equal(t, int32(3), resp.TotalSize)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = server.SearchPendingGames(ctx, &pb.SearchPendingGamesRequest{
+		OrderBy:   pb.PendingGameOrder_ORDER_NEWEST_FIRST,
+		PageSize:  2,
+		PageToken: resp.NextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Games, 1)
+	assert.Equal(t, first.GameId, resp.Games[0].GameId)
+	assert.Empty(t, resp.NextPageToken)
+
+	// The caller's own games are excluded
+	resp, err = server.SearchPendingGames(ctx, &pb.SearchPendingGamesRequest{UserId: "player1"})
+	require.NoError(t, err)
+	require.Len(t, resp.Games, 2)
+	assert.Equal(t, second.GameId, resp.Games[0].GameId)
+
+	resp, err = server.SearchPendingGames(ctx, &pb.SearchPendingGamesRequest{CreatorId: "player3", MaxAgeSeconds: 60})
+	require.NoError(t, err)
+	require.Len(t, resp.Games, 1)
+	assert.Equal(t, third.GameId, resp.Games[0].GameId)
+
+	_, err = server.SearchPendingGames(ctx, &pb.SearchPendingGamesRequest{PageToken: "%%%"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPrivateGames(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	start, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", Private: true})
+	require.NoError(t, err)
+	require.NotEmpty(t, start.JoinCode)
+
+	search, err := server.SearchPendingGames(ctx, &pb.SearchPendingGamesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, search.Games)
+
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: start.GameId})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Without a user ID nobody can take the seat
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{GameId: start.GameId})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{JoinCode: start.JoinCode})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: start.GameId, JoinCode: start.JoinCode})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	join, err := server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", JoinCode: start.JoinCode})
+	require.NoError(t, err)
+	assert.Equal(t, start.GameId, join.Game.Id)
+	assert.True(t, join.Game.Private)
+
+	// An invitation admits the invited user by game ID only
+	invite, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", InvitedUserId: "player3"})
+	require.NoError(t, err)
+
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player4", GameId: invite.GameId})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	join, err = server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player3", GameId: invite.GameId})
+	require.NoError(t, err)
+	assert.Equal(t, pb.GameStatus_IN_PROGRESS, join.Status)
+}
+
+func TestListUserGames(t *testing.T) {
+	server := setupTestServer()
+	ctx := context.Background()
+
+	// player1 waits for an opponent in one game and is due to move in another
+	pending, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardSize: 4})
+	require.NoError(t, err)
+	active, err := server.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardSize: 3})
+	require.NoError(t, err)
+	_, err = server.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: active.GameId})
+	require.NoError(t, err)
+
+	resp, err := server.ListUserGames(ctx, &pb.ListUserGamesRequest{UserId: "player1"})
+	require.NoError(t, err)
+	require.Len(t, resp.Games, 2)
+	assert.Equal(t, active.GameId, resp.Games[0].Game.Id)
+	assert.True(t, resp.Games[0].YourTurn)
+	assert.Equal(t, pending.GameId, resp.Games[1].Game.Id)
+	assert.False(t, resp.Games[1].YourTurn)
+
+	// player2 sees the shared game, but it is not their turn
+	resp, err = server.ListUserGames(ctx, &pb.ListUserGamesRequest{UserId: "player2"})
+	require.NoError(t, err)
+	require.Len(t, resp.Games, 1)
+	assert.False(t, resp.Games[0].YourTurn)
+
+	// Filtering and paging
+	resp, err = server.ListUserGames(ctx, &pb.ListUserGamesRequest{
+		UserId:       "player1",
+		StatusFilter: []pb.GameStatus{pb.GameStatus_PENDING},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Games, 1)
+	assert.Equal(t, pending.GameId, resp.Games[0].Game.Id)
+
+	resp, err = server.ListUserGames(ctx, &pb.ListUserGamesRequest{UserId: "player1", PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Games, 1)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = server.ListUserGames(ctx, &pb.ListUserGamesRequest{UserId: "player1", PageSize: 1, PageToken: resp.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, resp.Games, 1)
+	assert.Equal(t, pending.GameId, resp.Games[0].Game.Id)
+	assert.Empty(t, resp.NextPageToken)
+
+	_, err = server.ListUserGames(ctx, &pb.ListUserGamesRequest{UserId: "player1", PageToken: "%%%"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 }
@@ -0,0 +1,156 @@
+// test/acceptance/gateway_acceptance_test.go
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"tictactoe/internal/adapters/rest"
+	pb "tictactoe/proto"
+)
+
+func setupTestGateway(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	pb.RegisterTicTacToeServiceServer(grpcServer, setupTestServer())
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	handler, err := rest.NewHandler(context.Background(), conn)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func doJSON(t *testing.T, method, url, body string) (int, map[string]any) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var decoded map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+	return resp.StatusCode, decoded
+}
+
+func TestGatewayGameFlow(t *testing.T) {
+	server := setupTestGateway(t)
+
+	code, start := doJSON(t, http.MethodPost, server.URL+"/v1/games", `{"user_id":"player1","board_size":3,"winning_length":3}`)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "PENDING", start["status"])
+	gameID := start["game_id"].(string)
+
+	code, pending := doJSON(t, http.MethodGet, server.URL+"/v1/pending-games?board_size=3", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Len(t, pending["games"], 1)
+	assert.Equal(t, float64(1), pending["total_size"])
+
+	code, pending = doJSON(t, http.MethodGet, server.URL+"/v1/pending-games?user_id=player1&order_by=ORDER_NEWEST_FIRST", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Empty(t, pending["games"])
+
+	code, join := doJSON(t, http.MethodPost, server.URL+"/v1/games/"+gameID+"/join", `{"user_id":"player2"}`)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "IN_PROGRESS", join["status"])
+
+	moves := []string{
+		`{"user_id":"player1","row":0,"col":0}`,
+		`{"user_id":"player2","row":1,"col":0}`,
+		`{"user_id":"player1","row":0,"col":1}`,
+		`{"user_id":"player2","row":1,"col":1}`,
+		`{"user_id":"player1","row":0,"col":2}`,
+	}
+	var last map[string]any
+	for _, move := range moves {
+		code, last = doJSON(t, http.MethodPost, server.URL+"/v1/games/"+gameID+"/moves", move)
+		require.Equal(t, http.StatusOK, code)
+	}
+	assert.Equal(t, "FINISHED_WIN", last["status"])
+
+	code, game := doJSON(t, http.MethodGet, server.URL+"/v1/games/"+gameID+"?user_id=player1", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "player1", game["game"].(map[string]any)["winner_id"])
+
+	code, stats := doJSON(t, http.MethodGet, server.URL+"/v1/users/player1/stats", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(1), stats["stats"].(map[string]any)["wins"])
+
+	code, list := doJSON(t, http.MethodGet, server.URL+"/v1/users/player1/games?status_filter=FINISHED_WIN", "")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, list["games"], 1)
+	assert.Equal(t, false, list["games"].([]any)[0].(map[string]any)["your_turn"])
+}
+
+func TestGatewayErrorMapping(t *testing.T) {
+	server := setupTestGateway(t)
+
+	code, body := doJSON(t, http.MethodGet, server.URL+"/v1/games/nonexistent?user_id=player1", "")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Contains(t, body["message"], "game not found")
+
+	_, start := doJSON(t, http.MethodPost, server.URL+"/v1/games", `{"user_id":"player1"}`)
+	gameID := start["game_id"].(string)
+
+	code, _ = doJSON(t, http.MethodGet, server.URL+"/v1/games/"+gameID+"?user_id=intruder", "")
+	assert.Equal(t, http.StatusForbidden, code)
+
+	code, _ = doJSON(t, http.MethodPost, server.URL+"/v1/games/"+gameID+"/join", `{"user_id":"player1"}`)
+	assert.Equal(t, http.StatusConflict, code)
+
+	doJSON(t, http.MethodPost, server.URL+"/v1/games/"+gameID+"/join", `{"user_id":"player2"}`)
+	code, _ = doJSON(t, http.MethodPost, server.URL+"/v1/games/"+gameID+"/moves", `{"user_id":"player1","row":9,"col":9}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestGatewayJoinCode(t *testing.T) {
+	server := setupTestGateway(t)
+
+	code, start := doJSON(t, http.MethodPost, server.URL+"/v1/games", `{"user_id":"player1","private":true}`)
+	require.Equal(t, http.StatusOK, code)
+	joinCode := start["join_code"].(string)
+
+	code, _ = doJSON(t, http.MethodPost, server.URL+"/v1/games/"+start["game_id"].(string)+"/join", `{"user_id":"player2"}`)
+	assert.Equal(t, http.StatusForbidden, code)
+
+	code, join := doJSON(t, http.MethodPost, server.URL+"/v1/join-codes/"+joinCode+"/join", `{"user_id":"player2"}`)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "IN_PROGRESS", join["status"])
+}
+
+func TestGatewayOpenAPIDocument(t *testing.T) {
+	server := setupTestGateway(t)
+
+	code, doc := doJSON(t, http.MethodGet, server.URL+"/openapi.json", "")
+	require.Equal(t, http.StatusOK, code)
+	paths := doc["paths"].(map[string]any)
+	assert.Contains(t, paths, "/v1/games/{game_id}/moves")
+	assert.Contains(t, paths, "/v1/users/{user_id}/stats")
+}
@@ -0,0 +1,213 @@
+// test/acceptance/spectate_acceptance_test.go
+package integration
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"tictactoe/internal/adapters/grpc/handler"
+	pb "tictactoe/proto"
+)
+
+func setupSpectateServer(t *testing.T) (pb.TicTacToeServiceClient, *handler.GRPCHandler) {
+	t.Helper()
+
+	grpcHandler := setupTestServer()
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	pb.RegisterTicTacToeServiceServer(server, grpcHandler)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewTicTacToeServiceClient(conn), grpcHandler
+}
+
+func startMatch(t *testing.T, client pb.TicTacToeServiceClient) string {
+	t.Helper()
+	ctx := context.Background()
+
+	start, err := client.StartGame(ctx, &pb.StartGameRequest{UserId: "player1", BoardSize: 3, WinningLength: 3})
+	require.NoError(t, err)
+	_, err = client.JoinGame(ctx, &pb.JoinGameRequest{UserId: "player2", GameId: start.GameId})
+	require.NoError(t, err)
+	return start.GameId
+}
+
+func TestSpectateGame(t *testing.T) {
+	client, _ := setupSpectateServer(t)
+	gameID := startMatch(t, client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.SpectateGame(ctx, &pb.SpectateGameRequest{GameId: gameID, UserId: "viewer"})
+	require.NoError(t, err)
+
+	snapshot, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, pb.GameStatus_IN_PROGRESS, snapshot.Game.Status)
+	assert.Equal(t, int32(1), snapshot.Game.SpectatorCount)
+
+	// The spectator shows up in discovery
+	live, err := client.ListLiveGames(ctx, &pb.ListLiveGamesRequest{})
+	require.NoError(t, err)
+	require.Len(t, live.Games, 1)
+	assert.Equal(t, int32(1), live.Games[0].SpectatorCount)
+
+	// Play to a win; every move reaches the spectator and the stream ends
+	moves := [][3]any{{"player1", 0, 0}, {"player2", 1, 0}, {"player1", 0, 1}, {"player2", 1, 1}, {"player1", 0, 2}}
+	for _, move := range moves {
+		_, err := client.MakeMove(ctx, &pb.MakeMoveRequest{
+			UserId: move[0].(string), GameId: gameID, Row: int32(move[1].(int)), Col: int32(move[2].(int)),
+		})
+		require.NoError(t, err)
+	}
+
+	var last *pb.Game
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		last = resp.Game
+	}
+	require.NotNil(t, last)
+	assert.Equal(t, pb.GameStatus_FINISHED_WIN, last.Status)
+	assert.Equal(t, "player1", last.WinnerId)
+}
+
+func TestSpectateGameForbidden(t *testing.T) {
+	client, _ := setupSpectateServer(t)
+	gameID := startMatch(t, client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.SpectateGame(ctx, &pb.SpectateGameRequest{GameId: gameID, UserId: "viewer"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	// Only the creator may close the game, which drops current spectators
+	_, err = client.SetSpectatorsAllowed(ctx, &pb.SetSpectatorsAllowedRequest{GameId: gameID, UserId: "player2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := client.SetSpectatorsAllowed(ctx, &pb.SetSpectatorsAllowedRequest{GameId: gameID, UserId: "player1"})
+	require.NoError(t, err)
+	assert.False(t, resp.Game.SpectatorsAllowed)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	stream, err = client.SpectateGame(ctx, &pb.SpectateGameRequest{GameId: gameID, UserId: "viewer"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	live, err := client.ListLiveGames(ctx, &pb.ListLiveGamesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, live.Games)
+}
+
+func TestSpectateGameEndsOnClose(t *testing.T) {
+	client, grpcHandler := setupSpectateServer(t)
+	gameID := startMatch(t, client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.SpectateGame(ctx, &pb.SpectateGameRequest{GameId: gameID, UserId: "viewer"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	grpcHandler.Close()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// The spectator was removed when the stream ended
+	require.Eventually(t, func() bool {
+		game, err := client.GetGame(ctx, &pb.GetGameRequest{GameId: gameID, UserId: "player1"})
+		return err == nil && game.Game.SpectatorCount == 0
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestRematchNotifiesPlayers(t *testing.T) {
+	client, _ := setupSpectateServer(t)
+	gameID := startMatch(t, client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.SpectateGame(ctx, &pb.SpectateGameRequest{GameId: gameID, UserId: "player2"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	_, err = client.OfferRematch(ctx, &pb.OfferRematchRequest{GameId: gameID, UserId: "player1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	moves := [][3]any{{"player1", 0, 0}, {"player2", 1, 0}, {"player1", 0, 1}, {"player2", 1, 1}, {"player1", 0, 2}}
+	for _, move := range moves {
+		_, err := client.MakeMove(ctx, &pb.MakeMoveRequest{
+			UserId: move[0].(string), GameId: gameID, Row: int32(move[1].(int)), Col: int32(move[2].(int)),
+		})
+		require.NoError(t, err)
+	}
+
+	// recvUntil reads the player's stream up to the first matching update
+	recvUntil := func(match func(*pb.Game) bool) *pb.Game {
+		for {
+			resp, err := stream.Recv()
+			require.NoError(t, err)
+			if match(resp.Game) {
+				return resp.Game
+			}
+		}
+	}
+	recvUntil(func(g *pb.Game) bool { return g.Status == pb.GameStatus_FINISHED_WIN })
+
+	offer, err := client.OfferRematch(ctx, &pb.OfferRematchRequest{GameId: gameID, UserId: "player1"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.RematchStatus_REMATCH_OFFERED, offer.Game.Rematch.Status)
+
+	// The opponent hears of the offer on their stream
+	seen := recvUntil(func(g *pb.Game) bool { return g.Rematch != nil })
+	assert.Equal(t, "player1", seen.Rematch.OfferedBy)
+	assert.Greater(t, seen.Rematch.ExpiresAt, time.Now().Unix()-1)
+
+	_, err = client.AcceptRematch(ctx, &pb.AcceptRematchRequest{GameId: gameID, UserId: "player1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	accept, err := client.AcceptRematch(ctx, &pb.AcceptRematchRequest{GameId: gameID, UserId: "player2"})
+	require.NoError(t, err)
+	assert.Equal(t, "player2", accept.Game.Player1Id)
+	assert.Equal(t, "player1", accept.Game.Player2Id)
+	assert.Equal(t, pb.GameStatus_IN_PROGRESS, accept.Game.Status)
+
+	// The stream ends once the rematch is settled
+	seen = recvUntil(func(g *pb.Game) bool { return g.Rematch.Status != pb.RematchStatus_REMATCH_OFFERED })
+	assert.Equal(t, pb.RematchStatus_REMATCH_ACCEPTED, seen.Rematch.Status)
+	assert.Equal(t, accept.Game.Id, seen.Rematch.GameId)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
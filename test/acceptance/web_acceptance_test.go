@@ -0,0 +1,110 @@
+// test/acceptance/web_acceptance_test.go
+package integration
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"tictactoe/internal/adapters/connectapi"
+	pb "tictactoe/proto"
+	"tictactoe/proto/protoconnect"
+)
+
+func setupTestWebServer(t *testing.T, cfg connectapi.CORSConfig) *httptest.Server {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	pb.RegisterTicTacToeServiceServer(grpcServer, setupTestServer())
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	server := httptest.NewServer(connectapi.NewHandler(conn, cfg))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWebProtocolsGameFlow(t *testing.T) {
+	server := setupTestWebServer(t, connectapi.CORSConfig{})
+
+	protocols := map[string][]connect.ClientOption{
+		"connect-json":  {connect.WithProtoJSON()},
+		"connect-proto": nil,
+		"grpc-web":      {connect.WithGRPCWeb()},
+	}
+	for name, opts := range protocols {
+		t.Run(name, func(t *testing.T) {
+			client := protoconnect.NewTicTacToeServiceClient(server.Client(), server.URL, opts...)
+			ctx := context.Background()
+			player1, player2 := "web1-"+name, "web2-"+name
+
+			start, err := client.StartGame(ctx, connect.NewRequest(&pb.StartGameRequest{UserId: player1, BoardSize: 3, WinningLength: 3}))
+			require.NoError(t, err)
+			assert.Equal(t, pb.GameStatus_PENDING, start.Msg.Status)
+
+			join, err := client.JoinGame(ctx, connect.NewRequest(&pb.JoinGameRequest{UserId: player2, GameId: start.Msg.GameId}))
+			require.NoError(t, err)
+			assert.Equal(t, pb.GameStatus_IN_PROGRESS, join.Msg.Game.Status)
+
+			move, err := client.MakeMove(ctx, connect.NewRequest(&pb.MakeMoveRequest{UserId: player1, GameId: start.Msg.GameId, Row: 0, Col: 0}))
+			require.NoError(t, err)
+			assert.Equal(t, "X", move.Msg.Game.Board[0])
+
+			_, err = client.MakeMove(ctx, connect.NewRequest(&pb.MakeMoveRequest{UserId: player1, GameId: start.Msg.GameId, Row: 1, Col: 1}))
+			assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+			_, err = client.GetGame(ctx, connect.NewRequest(&pb.GetGameRequest{GameId: "missing", UserId: player1}))
+			assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+			streamCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := client.SpectateGame(streamCtx, connect.NewRequest(&pb.SpectateGameRequest{GameId: start.Msg.GameId, UserId: "viewer"}))
+			require.NoError(t, err)
+			require.True(t, stream.Receive(), "stream ended: %v", stream.Err())
+			assert.Equal(t, int32(1), stream.Msg().Game.SpectatorCount)
+			assert.Equal(t, "X", stream.Msg().Game.Board[0])
+		})
+	}
+}
+
+func TestWebProtocolsCORS(t *testing.T) {
+	server := setupTestWebServer(t, connectapi.CORSConfig{AllowedOrigins: []string{"https://app.example.com"}})
+	url := server.URL + protoconnect.TicTacToeServiceGetGameProcedure
+
+	preflight := func(origin string) *http.Response {
+		req, err := http.NewRequest(http.MethodOptions, url, nil)
+		require.NoError(t, err)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web,x-user-agent")
+
+		resp, err := server.Client().Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	resp := preflight("https://app.example.com")
+	assert.Equal(t, "https://app.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, resp.Header.Get("Access-Control-Allow-Headers"), "x-grpc-web")
+
+	resp = preflight("https://evil.example.com")
+	assert.Empty(t, resp.Header.Get("Access-Control-Allow-Origin"))
+}